@@ -0,0 +1,304 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/google/kube-startup-cpu-boost/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var _ conversion.Convertible = &StartupCPUBoost{}
+
+// ConvertTo converts this StartupCPUBoost to the Hub version (v1beta1).
+// The deprecated container name is converted to the ExactName container matcher.
+func (src *StartupCPUBoost) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.StartupCPUBoost)
+	in := src.DeepCopy()
+	dst.ObjectMeta = in.ObjectMeta
+	dst.Spec.Selector = in.Selector
+	dst.Spec.ResourcePolicy.ContainerPolicies = nil
+	for i, policy := range in.Spec.ResourcePolicy.ContainerPolicies {
+		converted, err := convertContainerPolicyTo(policy)
+		if err != nil {
+			return fmt.Errorf("spec.resourcePolicy.containerPolicies[%d]: %w", i, err)
+		}
+		dst.Spec.ResourcePolicy.ContainerPolicies = append(dst.Spec.ResourcePolicy.ContainerPolicies, converted)
+	}
+	dst.Spec.DurationPolicy = v1beta1.DurationPolicy{}
+	if fixed := in.Spec.DurationPolicy.Fixed; fixed != nil {
+		dst.Spec.DurationPolicy.Fixed = &v1beta1.FixedDurationPolicy{
+			Unit:  v1beta1.FixedDurationPolicyUnit(fixed.Unit),
+			Value: fixed.Value,
+		}
+	}
+	if podCondition := in.Spec.DurationPolicy.PodCondition; podCondition != nil {
+		dst.Spec.DurationPolicy.PodCondition = &v1beta1.PodConditionDurationPolicy{
+			Type:   podCondition.Type,
+			Status: podCondition.Status,
+		}
+	}
+	dst.Spec.DriftPolicy = v1beta1.DriftPolicy(in.Spec.DriftPolicy)
+	dst.Spec.Priority = in.Spec.Priority
+	dst.Spec.TargetRef = in.Spec.TargetRef
+	dst.Spec.Overrides = convertOverridePolicyTo(in.Spec.Overrides)
+	if ref := in.Spec.ProfileRef; ref != nil {
+		dst.Spec.ProfileRef = &v1beta1.ProfileReference{
+			Kind: v1beta1.ProfileKind(ref.Kind),
+			Name: ref.Name,
+		}
+	}
+	dst.Spec.Suspend = in.Spec.Suspend
+	dst.Spec.Mode = v1beta1.BoostMode(in.Spec.Mode)
+	dst.Status = convertStatusTo(in.Status)
+	return nil
+}
+
+// ConvertFrom converts the Hub version (v1beta1) to this StartupCPUBoost
+func (dst *StartupCPUBoost) ConvertFrom(srcRaw conversion.Hub) error {
+	in := srcRaw.(*v1beta1.StartupCPUBoost).DeepCopy()
+	dst.ObjectMeta = in.ObjectMeta
+	dst.Selector = in.Spec.Selector
+	dst.Spec.ResourcePolicy.ContainerPolicies = nil
+	for _, policy := range in.Spec.ResourcePolicy.ContainerPolicies {
+		dst.Spec.ResourcePolicy.ContainerPolicies = append(dst.Spec.ResourcePolicy.ContainerPolicies,
+			convertContainerPolicyFrom(policy))
+	}
+	dst.Spec.DurationPolicy = DurationPolicy{}
+	if fixed := in.Spec.DurationPolicy.Fixed; fixed != nil {
+		dst.Spec.DurationPolicy.Fixed = &FixedDurationPolicy{
+			Unit:  FixedDurationPolicyUnit(fixed.Unit),
+			Value: fixed.Value,
+		}
+	}
+	if podCondition := in.Spec.DurationPolicy.PodCondition; podCondition != nil {
+		dst.Spec.DurationPolicy.PodCondition = &PodConditionDurationPolicy{
+			Type:   podCondition.Type,
+			Status: podCondition.Status,
+		}
+	}
+	dst.Spec.DriftPolicy = DriftPolicy(in.Spec.DriftPolicy)
+	dst.Spec.Priority = in.Spec.Priority
+	dst.Spec.TargetRef = in.Spec.TargetRef
+	dst.Spec.Overrides = convertOverridePolicyFrom(in.Spec.Overrides)
+	if ref := in.Spec.ProfileRef; ref != nil {
+		dst.Spec.ProfileRef = &ProfileReference{
+			Kind: ProfileKind(ref.Kind),
+			Name: ref.Name,
+		}
+	}
+	dst.Spec.Suspend = in.Spec.Suspend
+	dst.Spec.Mode = BoostMode(in.Spec.Mode)
+	dst.Status = convertStatusFrom(in.Status)
+	return nil
+}
+
+func convertContainerPolicyTo(policy ContainerPolicy) (v1beta1.ContainerPolicy, error) {
+	var dst v1beta1.ContainerPolicy
+	//lint:ignore SA1019 backwards-compatible support for deprecated ContainerName
+	containerName := policy.ContainerName
+	switch {
+	case containerName != "" && policy.MatchContainers != nil:
+		return dst, fmt.Errorf("either container name or container matcher should be defined")
+	case containerName != "":
+		dst.MatchContainers = &v1beta1.MatchContainers{
+			Type:  v1beta1.MatchContainersTypeExactName,
+			Value: containerName,
+		}
+	case policy.MatchContainers != nil:
+		dst.MatchContainers = &v1beta1.MatchContainers{
+			Type:    v1beta1.MatchContainersType(policy.MatchContainers.Type),
+			Value:   policy.MatchContainers.Value,
+			Tag:     policy.MatchContainers.Tag,
+			Digest:  policy.MatchContainers.Digest,
+			Include: convertMatchContainersRulesTo(policy.MatchContainers.Include),
+			Exclude: convertMatchContainersRulesTo(policy.MatchContainers.Exclude),
+		}
+	}
+	if policy.MatchPod != nil {
+		dst.MatchPod = &v1beta1.MatchPod{
+			Selector:           policy.MatchPod.Selector.DeepCopy(),
+			PriorityClassNames: policy.MatchPod.PriorityClassNames,
+			OwnerKinds:         policy.MatchPod.OwnerKinds,
+			NodeSelectorKeys:   policy.MatchPod.NodeSelectorKeys,
+		}
+	}
+	if policy.PercentageIncrease != nil {
+		dst.PercentageIncrease = &v1beta1.PercentageIncrease{
+			Value: policy.PercentageIncrease.Value,
+		}
+	}
+	if policy.FixedResources != nil {
+		dst.FixedResources = &v1beta1.FixedResources{
+			Requests: policy.FixedResources.Requests,
+			Limits:   policy.FixedResources.Limits,
+		}
+	}
+	return dst, nil
+}
+
+func convertMatchContainersRulesTo(rules []MatchContainersRule) []v1beta1.MatchContainersRule {
+	if rules == nil {
+		return nil
+	}
+	dst := make([]v1beta1.MatchContainersRule, 0, len(rules))
+	for _, rule := range rules {
+		dst = append(dst, v1beta1.MatchContainersRule{
+			Type:   v1beta1.MatchContainersType(rule.Type),
+			Value:  rule.Value,
+			Tag:    rule.Tag,
+			Digest: rule.Digest,
+		})
+	}
+	return dst
+}
+
+func convertContainerPolicyFrom(policy v1beta1.ContainerPolicy) ContainerPolicy {
+	var dst ContainerPolicy
+	if policy.MatchContainers != nil {
+		dst.MatchContainers = &MatchContainers{
+			Type:    MatchContainersType(policy.MatchContainers.Type),
+			Value:   policy.MatchContainers.Value,
+			Tag:     policy.MatchContainers.Tag,
+			Digest:  policy.MatchContainers.Digest,
+			Include: convertMatchContainersRulesFrom(policy.MatchContainers.Include),
+			Exclude: convertMatchContainersRulesFrom(policy.MatchContainers.Exclude),
+		}
+	}
+	if policy.MatchPod != nil {
+		dst.MatchPod = &MatchPod{
+			Selector:           policy.MatchPod.Selector.DeepCopy(),
+			PriorityClassNames: policy.MatchPod.PriorityClassNames,
+			OwnerKinds:         policy.MatchPod.OwnerKinds,
+			NodeSelectorKeys:   policy.MatchPod.NodeSelectorKeys,
+		}
+	}
+	if policy.PercentageIncrease != nil {
+		dst.PercentageIncrease = &PercentageIncrease{
+			Value: policy.PercentageIncrease.Value,
+		}
+	}
+	if policy.FixedResources != nil {
+		dst.FixedResources = &FixedResources{
+			Requests: policy.FixedResources.Requests,
+			Limits:   policy.FixedResources.Limits,
+		}
+	}
+	return dst
+}
+
+func convertMatchContainersRulesFrom(rules []v1beta1.MatchContainersRule) []MatchContainersRule {
+	if rules == nil {
+		return nil
+	}
+	dst := make([]MatchContainersRule, 0, len(rules))
+	for _, rule := range rules {
+		dst = append(dst, MatchContainersRule{
+			Type:   MatchContainersType(rule.Type),
+			Value:  rule.Value,
+			Tag:    rule.Tag,
+			Digest: rule.Digest,
+		})
+	}
+	return dst
+}
+
+func convertOverridePolicyTo(policy *OverridePolicy) *v1beta1.OverridePolicy {
+	if policy == nil {
+		return nil
+	}
+	dst := &v1beta1.OverridePolicy{}
+	if percentage := policy.Percentage; percentage != nil {
+		dst.Percentage = &v1beta1.PercentageOverrideRange{
+			Min: percentage.Min,
+			Max: percentage.Max,
+		}
+	}
+	if duration := policy.Duration; duration != nil {
+		dst.Duration = &v1beta1.DurationOverrideRange{
+			Unit: v1beta1.FixedDurationPolicyUnit(duration.Unit),
+			Min:  duration.Min,
+			Max:  duration.Max,
+		}
+	}
+	return dst
+}
+
+func convertOverridePolicyFrom(policy *v1beta1.OverridePolicy) *OverridePolicy {
+	if policy == nil {
+		return nil
+	}
+	dst := &OverridePolicy{}
+	if percentage := policy.Percentage; percentage != nil {
+		dst.Percentage = &PercentageOverrideRange{
+			Min: percentage.Min,
+			Max: percentage.Max,
+		}
+	}
+	if duration := policy.Duration; duration != nil {
+		dst.Duration = &DurationOverrideRange{
+			Unit: FixedDurationPolicyUnit(duration.Unit),
+			Min:  duration.Min,
+			Max:  duration.Max,
+		}
+	}
+	return dst
+}
+
+func convertStatusTo(status StartupCPUBoostStatus) v1beta1.StartupCPUBoostStatus {
+	dst := v1beta1.StartupCPUBoostStatus{
+		ActiveContainerBoosts:     status.ActiveContainerBoosts,
+		TotalContainerBoosts:      status.TotalContainerBoosts,
+		DeferredContainerBoosts:   status.DeferredContainerBoosts,
+		InfeasibleContainerBoosts: status.InfeasibleContainerBoosts,
+		ExtraCPU:                  status.ExtraCPU,
+		ExtraCPUCoreSeconds:       status.ExtraCPUCoreSeconds,
+		DryRunContainerBoosts:     status.DryRunContainerBoosts,
+		DryRunExtraCPU:            status.DryRunExtraCPU,
+		ObservedGeneration:        status.ObservedGeneration,
+		Conditions:                status.Conditions,
+	}
+	for _, skipped := range status.SkippedContainerBoosts {
+		dst.SkippedContainerBoosts = append(dst.SkippedContainerBoosts,
+			v1beta1.SkippedContainerBoosts(skipped))
+	}
+	for _, pod := range status.ActivePods {
+		dst.ActivePods = append(dst.ActivePods, v1beta1.ActivePodBoost(pod))
+	}
+	return dst
+}
+
+func convertStatusFrom(status v1beta1.StartupCPUBoostStatus) StartupCPUBoostStatus {
+	dst := StartupCPUBoostStatus{
+		ActiveContainerBoosts:     status.ActiveContainerBoosts,
+		TotalContainerBoosts:      status.TotalContainerBoosts,
+		DeferredContainerBoosts:   status.DeferredContainerBoosts,
+		InfeasibleContainerBoosts: status.InfeasibleContainerBoosts,
+		ExtraCPU:                  status.ExtraCPU,
+		ExtraCPUCoreSeconds:       status.ExtraCPUCoreSeconds,
+		DryRunContainerBoosts:     status.DryRunContainerBoosts,
+		DryRunExtraCPU:            status.DryRunExtraCPU,
+		ObservedGeneration:        status.ObservedGeneration,
+		Conditions:                status.Conditions,
+	}
+	for _, skipped := range status.SkippedContainerBoosts {
+		dst.SkippedContainerBoosts = append(dst.SkippedContainerBoosts, SkippedContainerBoosts(skipped))
+	}
+	for _, pod := range status.ActivePods {
+		dst.ActivePods = append(dst.ActivePods, ActivePodBoost(pod))
+	}
+	return dst
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1_test
+
+import (
+	"github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	"github.com/google/kube-startup-cpu-boost/api/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("StartupCPUBoost conversion", func() {
+	var (
+		boost          *v1alpha1.StartupCPUBoost
+		hub            *v1beta1.StartupCPUBoost
+		extraCPU       = apiResource.MustParse("1500m")
+		dryRunExtraCPU = apiResource.MustParse("750m")
+		selector       = metav1.LabelSelector{
+			MatchLabels: map[string]string{"app.kubernetes.io/name": "demo"},
+		}
+	)
+	BeforeEach(func() {
+		boost = &v1alpha1.StartupCPUBoost{
+			ObjectMeta: metav1.ObjectMeta{Name: "boost-001", Namespace: "demo", Generation: 2},
+			Selector:   selector,
+			Spec: v1alpha1.StartupCPUBoostSpec{
+				ResourcePolicy: v1alpha1.ResourcePolicy{
+					ContainerPolicies: []v1alpha1.ContainerPolicy{
+						{
+							MatchContainers: &v1alpha1.MatchContainers{
+								Type:  v1alpha1.MatchContainersTypeRegexName,
+								Value: "^container-.*$",
+							},
+							PercentageIncrease: &v1alpha1.PercentageIncrease{Value: 50},
+						},
+						{
+							MatchContainers: &v1alpha1.MatchContainers{
+								Type:  v1alpha1.MatchContainersTypeExactName,
+								Value: "sidecar",
+							},
+							FixedResources: &v1alpha1.FixedResources{
+								Requests: apiResource.MustParse("1"),
+								Limits:   apiResource.MustParse("2"),
+							},
+						},
+						{
+							MatchContainers: &v1alpha1.MatchContainers{
+								Type:   v1alpha1.MatchContainersTypeImage,
+								Value:  "^eclipse-temurin$",
+								Tag:    "21-jre",
+								Digest: "sha256:abc123",
+							},
+							PercentageIncrease: &v1alpha1.PercentageIncrease{Value: 100},
+						},
+						{
+							MatchContainers: &v1alpha1.MatchContainers{
+								Type: v1alpha1.MatchContainersTypeComposite,
+								Include: []v1alpha1.MatchContainersRule{
+									{Type: v1alpha1.MatchContainersTypeRegexName, Value: "^app-"},
+								},
+								Exclude: []v1alpha1.MatchContainersRule{
+									{Type: v1alpha1.MatchContainersTypeExactName, Value: "istio-proxy"},
+									{Type: v1alpha1.MatchContainersTypeImage, Value: "fluent-bit$", Tag: "3"},
+								},
+							},
+							MatchPod: &v1alpha1.MatchPod{
+								Selector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"tier": "critical"},
+								},
+								PriorityClassNames: []string{"high-priority"},
+								OwnerKinds:         []string{"ReplicaSet"},
+								NodeSelectorKeys:   []string{"cloud.google.com/gke-nodepool"},
+							},
+							PercentageIncrease: &v1alpha1.PercentageIncrease{Value: 20},
+						},
+					},
+				},
+				DurationPolicy: v1alpha1.DurationPolicy{
+					Fixed: &v1alpha1.FixedDurationPolicy{
+						Unit:  v1alpha1.FixedDurationPolicyUnitMin,
+						Value: 2,
+					},
+					PodCondition: &v1alpha1.PodConditionDurationPolicy{
+						Type:   corev1.PodReady,
+						Status: corev1.ConditionTrue,
+					},
+				},
+				DriftPolicy: v1alpha1.DriftPolicyDelta,
+				Priority:    10,
+				TargetRef: &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "demo",
+				},
+				Overrides: &v1alpha1.OverridePolicy{
+					Percentage: &v1alpha1.PercentageOverrideRange{Min: 20, Max: 200},
+					Duration: &v1alpha1.DurationOverrideRange{
+						Unit: v1alpha1.FixedDurationPolicyUnitMin,
+						Min:  1,
+						Max:  5,
+					},
+				},
+				ProfileRef: &v1alpha1.ProfileReference{
+					Kind: v1alpha1.ProfileKindCluster,
+					Name: "jvm",
+				},
+				Suspend: true,
+				Mode:    v1alpha1.BoostModeDryRun,
+			},
+			Status: v1alpha1.StartupCPUBoostStatus{
+				ActiveContainerBoosts: 2,
+				TotalContainerBoosts:  5,
+				SkippedContainerBoosts: []v1alpha1.SkippedContainerBoosts{
+					{Reason: "SkippedQoSChange", Count: 1},
+				},
+				ActivePods: []v1alpha1.ActivePodBoost{
+					{Name: "pod-001", BoostTime: metav1.Now()},
+				},
+				ExtraCPU:              &extraCPU,
+				ExtraCPUCoreSeconds:   90,
+				DryRunContainerBoosts: 3,
+				DryRunExtraCPU:        &dryRunExtraCPU,
+				ObservedGeneration:    2,
+				Conditions: []metav1.Condition{
+					{Type: "Active", Status: metav1.ConditionTrue, Reason: "Ready"},
+				},
+			},
+		}
+		hub = &v1beta1.StartupCPUBoost{}
+	})
+	When("converts to the hub version", func() {
+		var err error
+		JustBeforeEach(func() {
+			err = boost.ConvertTo(hub)
+		})
+		It("does not error", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("moves the selector to the spec", func() {
+			Expect(hub.Spec.Selector).To(Equal(selector))
+		})
+		It("converts the spec and status", func() {
+			Expect(hub.Name).To(Equal(boost.Name))
+			Expect(hub.Spec.ResourcePolicy.ContainerPolicies).To(HaveLen(4))
+			Expect(hub.Spec.ResourcePolicy.ContainerPolicies[2].MatchContainers).To(Equal(&v1beta1.MatchContainers{
+				Type:   v1beta1.MatchContainersTypeImage,
+				Value:  "^eclipse-temurin$",
+				Tag:    "21-jre",
+				Digest: "sha256:abc123",
+			}))
+			Expect(hub.Spec.ResourcePolicy.ContainerPolicies[3].MatchPod.OwnerKinds).To(Equal([]string{"ReplicaSet"}))
+			Expect(hub.Spec.ResourcePolicy.ContainerPolicies[3].MatchContainers.Exclude).To(Equal(
+				[]v1beta1.MatchContainersRule{
+					{Type: v1beta1.MatchContainersTypeExactName, Value: "istio-proxy"},
+					{Type: v1beta1.MatchContainersTypeImage, Value: "fluent-bit$", Tag: "3"},
+				}))
+			Expect(hub.Spec.DurationPolicy.Fixed.Unit).To(Equal(v1beta1.FixedDurationPolicyUnitMin))
+			Expect(hub.Spec.DriftPolicy).To(Equal(v1beta1.DriftPolicyDelta))
+			Expect(hub.Spec.Priority).To(Equal(int32(10)))
+			Expect(hub.Spec.TargetRef).To(Equal(boost.Spec.TargetRef))
+			Expect(hub.Spec.Overrides.Percentage).To(Equal(&v1beta1.PercentageOverrideRange{Min: 20, Max: 200}))
+			Expect(hub.Spec.Overrides.Duration.Unit).To(Equal(v1beta1.FixedDurationPolicyUnitMin))
+			Expect(hub.Spec.ProfileRef).To(Equal(&v1beta1.ProfileReference{
+				Kind: v1beta1.ProfileKindCluster,
+				Name: "jvm",
+			}))
+			Expect(hub.Spec.Suspend).To(BeTrue())
+			Expect(hub.Spec.Mode).To(Equal(v1beta1.BoostModeDryRun))
+			Expect(hub.Status.ExtraCPU.String()).To(Equal("1500m"))
+			Expect(hub.Status.DryRunContainerBoosts).To(Equal(int32(3)))
+			Expect(hub.Status.DryRunExtraCPU.String()).To(Equal("750m"))
+			Expect(hub.Status.SkippedContainerBoosts).To(HaveLen(1))
+			Expect(hub.Status.ActivePods).To(HaveLen(1))
+		})
+		It("round-trips back to the same object", func() {
+			converted := &v1alpha1.StartupCPUBoost{}
+			Expect(converted.ConvertFrom(hub)).To(Succeed())
+			Expect(converted).To(Equal(boost))
+		})
+		When("container policy has deprecated container name", func() {
+			BeforeEach(func() {
+				policy := &boost.Spec.ResourcePolicy.ContainerPolicies[1]
+				policy.MatchContainers = nil
+				policy.ContainerName = "sidecar"
+			})
+			It("converts it to the exact name container matcher", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(hub.Spec.ResourcePolicy.ContainerPolicies[1].MatchContainers).To(Equal(
+					&v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeExactName,
+						Value: "sidecar",
+					}))
+			})
+		})
+		When("container policy has both container name and container matcher", func() {
+			BeforeEach(func() {
+				boost.Spec.ResourcePolicy.ContainerPolicies[1].ContainerName = "sidecar"
+			})
+			It("errors", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+	When("converts from the hub version", func() {
+		It("round-trips back to the same object", func() {
+			Expect(boost.ConvertTo(hub)).To(Succeed())
+			converted := &v1alpha1.StartupCPUBoost{}
+			Expect(converted.ConvertFrom(hub)).To(Succeed())
+			roundTripped := &v1beta1.StartupCPUBoost{}
+			Expect(converted.ConvertTo(roundTripped)).To(Succeed())
+			Expect(roundTripped).To(Equal(hub))
+		})
+	})
+})
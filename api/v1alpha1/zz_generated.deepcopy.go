@@ -19,17 +19,43 @@
 package v1alpha1
 
 import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePodBoost) DeepCopyInto(out *ActivePodBoost) {
+	*out = *in
+	in.BoostTime.DeepCopyInto(&out.BoostTime)
+	if in.ExpectedRevertTime != nil {
+		in, out := &in.ExpectedRevertTime, &out.ExpectedRevertTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePodBoost.
+func (in *ActivePodBoost) DeepCopy() *ActivePodBoost {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePodBoost)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerPolicy) DeepCopyInto(out *ContainerPolicy) {
 	*out = *in
 	if in.MatchContainers != nil {
 		in, out := &in.MatchContainers, &out.MatchContainers
 		*out = new(MatchContainers)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchPod != nil {
+		in, out := &in.MatchPod, &out.MatchPod
+		*out = new(MatchPod)
+		(*in).DeepCopyInto(*out)
 	}
 	if in.PercentageIncrease != nil {
 		in, out := &in.PercentageIncrease, &out.PercentageIncrease
@@ -53,6 +79,21 @@ func (in *ContainerPolicy) DeepCopy() *ContainerPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DurationOverrideRange) DeepCopyInto(out *DurationOverrideRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DurationOverrideRange.
+func (in *DurationOverrideRange) DeepCopy() *DurationOverrideRange {
+	if in == nil {
+		return nil
+	}
+	out := new(DurationOverrideRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DurationPolicy) DeepCopyInto(out *DurationPolicy) {
 	*out = *in
@@ -113,6 +154,16 @@ func (in *FixedResources) DeepCopy() *FixedResources {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MatchContainers) DeepCopyInto(out *MatchContainers) {
 	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]MatchContainersRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]MatchContainersRule, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchContainers.
@@ -125,6 +176,81 @@ func (in *MatchContainers) DeepCopy() *MatchContainers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchContainersRule) DeepCopyInto(out *MatchContainersRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchContainersRule.
+func (in *MatchContainersRule) DeepCopy() *MatchContainersRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchContainersRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchPod) DeepCopyInto(out *MatchPod) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PriorityClassNames != nil {
+		in, out := &in.PriorityClassNames, &out.PriorityClassNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OwnerKinds != nil {
+		in, out := &in.OwnerKinds, &out.OwnerKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelectorKeys != nil {
+		in, out := &in.NodeSelectorKeys, &out.NodeSelectorKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchPod.
+func (in *MatchPod) DeepCopy() *MatchPod {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverridePolicy) DeepCopyInto(out *OverridePolicy) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(PercentageOverrideRange)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(DurationOverrideRange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverridePolicy.
+func (in *OverridePolicy) DeepCopy() *OverridePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OverridePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PercentageIncrease) DeepCopyInto(out *PercentageIncrease) {
 	*out = *in
@@ -140,6 +266,21 @@ func (in *PercentageIncrease) DeepCopy() *PercentageIncrease {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PercentageOverrideRange) DeepCopyInto(out *PercentageOverrideRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PercentageOverrideRange.
+func (in *PercentageOverrideRange) DeepCopy() *PercentageOverrideRange {
+	if in == nil {
+		return nil
+	}
+	out := new(PercentageOverrideRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodConditionDurationPolicy) DeepCopyInto(out *PodConditionDurationPolicy) {
 	*out = *in
@@ -155,6 +296,21 @@ func (in *PodConditionDurationPolicy) DeepCopy() *PodConditionDurationPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileReference) DeepCopyInto(out *ProfileReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileReference.
+func (in *ProfileReference) DeepCopy() *ProfileReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourcePolicy) DeepCopyInto(out *ResourcePolicy) {
 	*out = *in
@@ -177,6 +333,21 @@ func (in *ResourcePolicy) DeepCopy() *ResourcePolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkippedContainerBoosts) DeepCopyInto(out *SkippedContainerBoosts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkippedContainerBoosts.
+func (in *SkippedContainerBoosts) DeepCopy() *SkippedContainerBoosts {
+	if in == nil {
+		return nil
+	}
+	out := new(SkippedContainerBoosts)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StartupCPUBoost) DeepCopyInto(out *StartupCPUBoost) {
 	*out = *in
@@ -242,6 +413,21 @@ func (in *StartupCPUBoostSpec) DeepCopyInto(out *StartupCPUBoostSpec) {
 	*out = *in
 	in.ResourcePolicy.DeepCopyInto(&out.ResourcePolicy)
 	in.DurationPolicy.DeepCopyInto(&out.DurationPolicy)
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(autoscalingv1.CrossVersionObjectReference)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(OverridePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProfileRef != nil {
+		in, out := &in.ProfileRef, &out.ProfileRef
+		*out = new(ProfileReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupCPUBoostSpec.
@@ -257,6 +443,28 @@ func (in *StartupCPUBoostSpec) DeepCopy() *StartupCPUBoostSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StartupCPUBoostStatus) DeepCopyInto(out *StartupCPUBoostStatus) {
 	*out = *in
+	if in.SkippedContainerBoosts != nil {
+		in, out := &in.SkippedContainerBoosts, &out.SkippedContainerBoosts
+		*out = make([]SkippedContainerBoosts, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActivePods != nil {
+		in, out := &in.ActivePods, &out.ActivePods
+		*out = make([]ActivePodBoost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraCPU != nil {
+		in, out := &in.ExtraCPU, &out.ExtraCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DryRunExtraCPU != nil {
+		in, out := &in.DryRunExtraCPU, &out.DryRunExtraCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
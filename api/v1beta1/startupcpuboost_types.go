@@ -0,0 +1,422 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FixedDurationPolicyUnit defines the unit of time for a fixed
+// time duration policy
+// +kubebuilder:validation:Enum=Seconds;Minutes
+type FixedDurationPolicyUnit string
+
+// MatchContainersType defines the type of the match containers rule
+// +kubebuilder:validation:Enum=ExactName;RegexName;Image;Composite
+type MatchContainersType string
+
+// ProfileKind defines the kind of the referenced StartupCPUBoost profile
+// +kubebuilder:validation:Enum=StartupCPUBoostProfile;ClusterStartupCPUBoostProfile
+type ProfileKind string
+
+// DriftPolicy defines the behavior of the boost reversion when container
+// CPU resources were changed by other actors during the boost
+// +kubebuilder:validation:Enum=Skip;Delta
+type DriftPolicy string
+
+// BoostMode defines if the StartupCPUBoost changes the POD resources
+// +kubebuilder:validation:Enum=Boost;DryRun
+type BoostMode string
+
+const (
+	FixedDurationPolicyUnitSec   FixedDurationPolicyUnit = "Seconds"
+	FixedDurationPolicyUnitMin   FixedDurationPolicyUnit = "Minutes"
+	MatchContainersTypeExactName MatchContainersType     = "ExactName"
+	MatchContainersTypeRegexName MatchContainersType     = "RegexName"
+	MatchContainersTypeImage     MatchContainersType     = "Image"
+	MatchContainersTypeComposite MatchContainersType     = "Composite"
+	DriftPolicySkip              DriftPolicy             = "Skip"
+	DriftPolicyDelta             DriftPolicy             = "Delta"
+	ProfileKindNamespaced        ProfileKind             = "StartupCPUBoostProfile"
+	ProfileKindCluster           ProfileKind             = "ClusterStartupCPUBoostProfile"
+	BoostModeBoost               BoostMode               = "Boost"
+	BoostModeDryRun              BoostMode               = "DryRun"
+)
+
+// FixedDurationPolicy defines the fixed time duration policy
+type FixedDurationPolicy struct {
+	// unit of time for a fixed time policy. Defaults to Seconds.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=Seconds
+	Unit FixedDurationPolicyUnit `json:"unit,omitempty"`
+	// duration value for a fixed time policy
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	Value int64 `json:"value,omitempty"`
+}
+
+// PodConditionDurationPolicy defines the PodCondition based
+// duration policy
+type PodConditionDurationPolicy struct {
+	// type of a PODCondition to check in a policy
+	Type corev1.PodConditionType `json:"type,omitempty"`
+	// status of a PODCondition to match in a policy
+	Status corev1.ConditionStatus `json:"status,omitempty"`
+}
+
+// DurationPolicy defines the policy used to determine the duration
+// time of a resource boost
+type DurationPolicy struct {
+	// fixed time duration policy
+	// +kubebuilder:validation:Optional
+	Fixed *FixedDurationPolicy `json:"fixedDuration,omitempty"`
+	// podCondition based duration policy
+	// +kubebuilder:validation:Optional
+	PodCondition *PodConditionDurationPolicy `json:"podCondition,omitempty"`
+}
+
+// FixedResources defines the CPU resource policy that sets CPU resources
+// to the given values
+type FixedResources struct {
+	// Requests specifies the CPU requests
+	// +kubebuilder:validation:Required
+	Requests resource.Quantity `json:"requests,omitempty"`
+	// Limits specifies the CPU requests
+	// +kubebuilder:validation:Optional
+	Limits resource.Quantity `json:"limits,omitempty"`
+}
+
+// PercentageIncrease defines the CPU resource policy that increases
+// CPU resources by the given percentage value
+type PercentageIncrease struct {
+	// Value specifies the percentage value
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	Value int64 `json:"value,omitempty"`
+}
+
+// MatchContainers specifies container matching rules
+type MatchContainers struct {
+	// Type of the match containers rule
+	// +kubebuilder:validation:Required
+	Type MatchContainersType `json:"type,omitempty"`
+	// Value of the match containers rule. Required by all but the Composite rule.
+	// +kubebuilder:validation:Optional
+	Value string `json:"value,omitempty"`
+	// Tag of the container image required by the Image match containers rule
+	// +kubebuilder:validation:Optional
+	Tag string `json:"tag,omitempty"`
+	// Digest of the container image required by the Image match containers rule
+	// +kubebuilder:validation:Optional
+	Digest string `json:"digest,omitempty"`
+	// Include lists the rules of the Composite match containers rule. The container
+	// is matched when any of the rules matches it. All containers are matched when
+	// the list is empty.
+	// +kubebuilder:validation:Optional
+	Include []MatchContainersRule `json:"include,omitempty"`
+	// Exclude lists the rules of the Composite match containers rule. The container
+	// is not matched when any of the rules matches it.
+	// +kubebuilder:validation:Optional
+	Exclude []MatchContainersRule `json:"exclude,omitempty"`
+}
+
+// MatchContainersRule specifies a single container matching rule of the
+// Composite match containers rule
+type MatchContainersRule struct {
+	// Type of the match containers rule. The Composite type is not supported.
+	// +kubebuilder:validation:Required
+	Type MatchContainersType `json:"type,omitempty"`
+	// Value of the match containers rule
+	// +kubebuilder:validation:Required
+	Value string `json:"value,omitempty"`
+	// Tag of the container image required by the Image match containers rule
+	// +kubebuilder:validation:Optional
+	Tag string `json:"tag,omitempty"`
+	// Digest of the container image required by the Image match containers rule
+	// +kubebuilder:validation:Optional
+	Digest string `json:"digest,omitempty"`
+}
+
+// MatchPod specifies POD matching rules. The POD is matched when all
+// the defined rules match it.
+type MatchPod struct {
+	// Selector specifies the label selector the POD has to match
+	// +kubebuilder:validation:Optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// PriorityClassNames lists the priority class names, one of which
+	// the POD has to have
+	// +kubebuilder:validation:Optional
+	PriorityClassNames []string `json:"priorityClassNames,omitempty"`
+	// OwnerKinds lists the kinds, one of which the POD controller has
+	// to have, i.e. ReplicaSet, StatefulSet or Job
+	// +kubebuilder:validation:Optional
+	OwnerKinds []string `json:"ownerKinds,omitempty"`
+	// NodeSelectorKeys lists the keys the POD node selector has to have
+	// +kubebuilder:validation:Optional
+	NodeSelectorKeys []string `json:"nodeSelectorKeys,omitempty"`
+}
+
+// ContainerPolicy defines the policy used to determine the target
+// resources for a container
+type ContainerPolicy struct {
+	// MatchContainers specifies container matching rules for a given policy
+	// +kubebuilder:validation:Required
+	MatchContainers *MatchContainers `json:"matchContainers,omitempty"`
+	// MatchPod specifies POD matching rules for a given policy. The policy
+	// applies to the containers of all PODs subject to the boost when not set.
+	// +kubebuilder:validation:Optional
+	MatchPod *MatchPod `json:"matchPod,omitempty"`
+	// PercentageIncrease specifies the CPU resource policy that increases
+	// CPU resources by the given percentage value
+	// +kubebuilder:validation:Optional
+	PercentageIncrease *PercentageIncrease `json:"percentageIncrease,omitempty"`
+	// FixedResources specifies the CPU resource policy that sets the CPU
+	// resources to the given values
+	// +kubebuilder:validation:Optional
+	FixedResources *FixedResources `json:"fixedResources,omitempty"`
+}
+
+// ResourcePolicy defines the policy used to determine the target
+// resources for a POD
+type ResourcePolicy struct {
+	// ContainerPolicies specifies resource policies for the containers. At least
+	// one container policy is required when the ProfileRef is not set.
+	// +kubebuilder:validation:Optional
+	ContainerPolicies []ContainerPolicy `json:"containerPolicies,omitempty"`
+}
+
+// ProfileReference references the StartupCPUBoost profile
+type ProfileReference struct {
+	// Kind of the referenced profile, either the namespaced StartupCPUBoostProfile
+	// or the ClusterStartupCPUBoostProfile. Defaults to StartupCPUBoostProfile.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=StartupCPUBoostProfile
+	Kind ProfileKind `json:"kind,omitempty"`
+	// Name of the referenced profile
+	// +kubebuilder:validation:Required
+	Name string `json:"name,omitempty"`
+}
+
+// PercentageOverrideRange defines the allowed range of the percentage
+// increase override
+type PercentageOverrideRange struct {
+	// Min specifies the minimal allowed percentage value
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	Min int64 `json:"min,omitempty"`
+	// Max specifies the maximal allowed percentage value
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	Max int64 `json:"max,omitempty"`
+}
+
+// DurationOverrideRange defines the allowed range of the fixed duration
+// override
+type DurationOverrideRange struct {
+	// unit of time for the range values. Defaults to Seconds.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=Seconds
+	Unit FixedDurationPolicyUnit `json:"unit,omitempty"`
+	// Min specifies the minimal allowed duration value
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	Min int64 `json:"min,omitempty"`
+	// Max specifies the maximal allowed duration value
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	Max int64 `json:"max,omitempty"`
+}
+
+// OverridePolicy defines the bounds of the boost settings that can be
+// overridden with the POD and namespace annotations
+type OverridePolicy struct {
+	// Percentage specifies the allowed range of the percentage increase
+	// override. The override is not allowed when not set.
+	// +kubebuilder:validation:Optional
+	Percentage *PercentageOverrideRange `json:"percentage,omitempty"`
+	// Duration specifies the allowed range of the fixed duration override.
+	// The override is not allowed when not set.
+	// +kubebuilder:validation:Optional
+	Duration *DurationOverrideRange `json:"duration,omitempty"`
+}
+
+// StartupCPUBoostSpec defines the desired state of StartupCPUBoost
+// +kubebuilder:validation:XValidation:rule="has(self.profileRef) || (has(self.resourcePolicy) && has(self.resourcePolicy.containerPolicies) && size(self.resourcePolicy.containerPolicies) > 0)",message="at least one container policy is required when profileRef is not set"
+type StartupCPUBoostSpec struct {
+	// Selector specifies the label selector of PODs subject to the boost
+	// +kubebuilder:validation:Optional
+	Selector metav1.LabelSelector `json:"selector,omitempty"`
+	// ResourcePolicy specifies policies for container resource increase
+	ResourcePolicy ResourcePolicy `json:"resourcePolicy,omitempty"`
+	// DurationPolicy specifies policies for resource boost duration. Required
+	// when the ProfileRef is not set.
+	// +kubebuilder:validation:Optional
+	DurationPolicy DurationPolicy `json:"durationPolicy,omitempty"`
+	// DriftPolicy specifies the behavior of the boost reversion when container
+	// CPU resources were changed by other actors (i.e. VPA) during the boost.
+	// Skip leaves the resources unchanged, Delta reverts only the boost increase.
+	// Defaults to Skip.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=Skip
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+	// Priority of the StartupCPUBoost when selectors of multiple boosts in
+	// a namespace match a POD. The boost with the highest priority is applied,
+	// boosts with equal priority are ordered by name. Defaults to 0.
+	// +kubebuilder:validation:Optional
+	Priority int32 `json:"priority,omitempty"`
+	// TargetRef references the workload controlling the PODs subject to the
+	// boost, i.e. a Deployment or a StatefulSet. The POD is matched when the
+	// workload is found in the chain of the POD controllers. The label selector
+	// should be empty when the TargetRef is set.
+	// +kubebuilder:validation:Optional
+	TargetRef *autoscalingv1.CrossVersionObjectReference `json:"targetRef,omitempty"`
+	// Overrides specifies the bounds of the boost settings that can be
+	// overridden with the POD and namespace annotations. The annotation
+	// disabling the boost is honoured regardless of this policy.
+	// +kubebuilder:validation:Optional
+	Overrides *OverridePolicy `json:"overrides,omitempty"`
+	// ProfileRef references the profile holding the resource and duration
+	// policies of the boost. The container policies of the boost take precedence
+	// over the ones of the profile and the duration policies of the boost replace
+	// the ones of the profile of the same type.
+	// +kubebuilder:validation:Optional
+	ProfileRef *ProfileReference `json:"profileRef,omitempty"`
+	// Suspend pauses the boost. While it is set, new PODs are not boosted and
+	// the already boosted PODs are still tracked and reverted. Defaults to false.
+	// +kubebuilder:validation:Optional
+	Suspend bool `json:"suspend,omitempty"`
+	// Mode specifies if the boost changes the POD resources. In the DryRun
+	// mode, the POD resources are not changed and the boost that would be
+	// applied is described in the POD annotation and the event. Defaults
+	// to Boost.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=Boost
+	Mode BoostMode `json:"mode,omitempty"`
+}
+
+// SkippedContainerBoosts defines the number of containers that were not
+// boosted for a given reason
+type SkippedContainerBoosts struct {
+	// Reason of skipping the container boost
+	// +kubebuilder:validation:Required
+	Reason string `json:"reason"`
+	// Count is the number of containers skipped for the reason
+	// +kubebuilder:validation:Optional
+	Count int32 `json:"count,omitempty"`
+}
+
+// ActivePodBoost defines the boost details of a POD which CPU resources
+// were not yet reverted
+type ActivePodBoost struct {
+	// Name of the POD
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// BoostTime is the time when the POD resources were boosted
+	// +kubebuilder:validation:Optional
+	BoostTime metav1.Time `json:"boostTime,omitempty"`
+	// ExpectedRevertTime is the time when the POD resources are expected
+	// to be reverted. It is set only for the fixed duration policy.
+	// +kubebuilder:validation:Optional
+	ExpectedRevertTime *metav1.Time `json:"expectedRevertTime,omitempty"`
+}
+
+// StartupCPUBoostStatus defines the observed state of StartupCPUBoost
+type StartupCPUBoostStatus struct {
+	// activeContainerBoosts is the number of containers which CPU
+	// resources were increased by the StartupCPUBoost and not yet
+	// reverted back to the original values
+	// +kubebuilder:validation:Optional
+	ActiveContainerBoosts int32 `json:"activeContainerBoosts,omitempty"`
+	// totalContainerBoosts is the number of containers which CPU
+	// resources were increased by the StartupCPUBoost
+	// +kubebuilder:validation:Optional
+	TotalContainerBoosts int32 `json:"totalContainerBoosts,omitempty"`
+	// deferredContainerBoosts is the number of boosted containers which
+	// in-place resize is deferred by the kubelet as it can't be granted
+	// at the moment
+	// +kubebuilder:validation:Optional
+	DeferredContainerBoosts int32 `json:"deferredContainerBoosts,omitempty"`
+	// infeasibleContainerBoosts is the number of boosted containers which
+	// in-place resize was found infeasible by the kubelet
+	// +kubebuilder:validation:Optional
+	InfeasibleContainerBoosts int32 `json:"infeasibleContainerBoosts,omitempty"`
+	// skippedContainerBoosts is the number of containers that matched the
+	// resource policy but were not boosted, by the reason of the skip
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=reason
+	SkippedContainerBoosts []SkippedContainerBoosts `json:"skippedContainerBoosts,omitempty"`
+	// activePods lists the PODs which CPU resources were boosted and not yet
+	// reverted, ordered by the boost time. The list is bounded and may not
+	// contain all the PODs counted in activeContainerBoosts.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=map
+	// +listMapKey=name
+	ActivePods []ActivePodBoost `json:"activePods,omitempty"`
+	// extraCPU is the CPU granted by the StartupCPUBoost on top of the
+	// original CPU requests of the containers not yet reverted
+	// +kubebuilder:validation:Optional
+	ExtraCPU *resource.Quantity `json:"extraCPU,omitempty"`
+	// extraCPUCoreSeconds is the number of extra CPU core-seconds granted
+	// by the StartupCPUBoost to the containers from the boost to the revert
+	// +kubebuilder:validation:Optional
+	ExtraCPUCoreSeconds int64 `json:"extraCPUCoreSeconds,omitempty"`
+	// dryRunContainerBoosts is the number of containers which CPU resources
+	// would be increased by the StartupCPUBoost in the DryRun mode
+	// +kubebuilder:validation:Optional
+	DryRunContainerBoosts int32 `json:"dryRunContainerBoosts,omitempty"`
+	// dryRunExtraCPU is the CPU that would be granted by the StartupCPUBoost
+	// in the DryRun mode on top of the original CPU requests of the containers
+	// +kubebuilder:validation:Optional
+	DryRunExtraCPU *resource.Quantity `json:"dryRunExtraCPU,omitempty"`
+	// observedGeneration is the most recent generation of the StartupCPUBoost
+	// observed by the controller
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions hold the latest available observations of the StartupCPUBoost
+	// current state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+
+// StartupCPUBoost is the Schema for the startupcpuboosts API
+type StartupCPUBoost struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StartupCPUBoostSpec   `json:"spec,omitempty"`
+	Status StartupCPUBoostStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// StartupCPUBoostList contains a list of StartupCPUBoost
+type StartupCPUBoostList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StartupCPUBoost `json:"items"`
+}
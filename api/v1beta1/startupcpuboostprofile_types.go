@@ -0,0 +1,69 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StartupCPUBoostProfileSpec defines the resource and duration policies shared
+// by the StartupCPUBoosts referencing the profile
+type StartupCPUBoostProfileSpec struct {
+	// ResourcePolicy specifies policies for container resource increase
+	// +kubebuilder:validation:Optional
+	ResourcePolicy ResourcePolicy `json:"resourcePolicy,omitempty"`
+	// DurationPolicy specifies policies for resource boost duration
+	// +kubebuilder:validation:Optional
+	DurationPolicy DurationPolicy `json:"durationPolicy,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// StartupCPUBoostProfile is the Schema for the startupcpuboostprofiles API
+type StartupCPUBoostProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec StartupCPUBoostProfileSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// StartupCPUBoostProfileList contains a list of StartupCPUBoostProfile
+type StartupCPUBoostProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StartupCPUBoostProfile `json:"items"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+
+// ClusterStartupCPUBoostProfile is the Schema for the clusterstartupcpuboostprofiles API
+type ClusterStartupCPUBoostProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec StartupCPUBoostProfileSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterStartupCPUBoostProfileList contains a list of ClusterStartupCPUBoostProfile
+type ClusterStartupCPUBoostProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterStartupCPUBoostProfile `json:"items"`
+}
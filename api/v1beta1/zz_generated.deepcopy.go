@@ -0,0 +1,618 @@
+//go:build !ignore_autogenerated
+
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePodBoost) DeepCopyInto(out *ActivePodBoost) {
+	*out = *in
+	in.BoostTime.DeepCopyInto(&out.BoostTime)
+	if in.ExpectedRevertTime != nil {
+		in, out := &in.ExpectedRevertTime, &out.ExpectedRevertTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePodBoost.
+func (in *ActivePodBoost) DeepCopy() *ActivePodBoost {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePodBoost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStartupCPUBoostProfile) DeepCopyInto(out *ClusterStartupCPUBoostProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStartupCPUBoostProfile.
+func (in *ClusterStartupCPUBoostProfile) DeepCopy() *ClusterStartupCPUBoostProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStartupCPUBoostProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStartupCPUBoostProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStartupCPUBoostProfileList) DeepCopyInto(out *ClusterStartupCPUBoostProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterStartupCPUBoostProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStartupCPUBoostProfileList.
+func (in *ClusterStartupCPUBoostProfileList) DeepCopy() *ClusterStartupCPUBoostProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStartupCPUBoostProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterStartupCPUBoostProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerPolicy) DeepCopyInto(out *ContainerPolicy) {
+	*out = *in
+	if in.MatchContainers != nil {
+		in, out := &in.MatchContainers, &out.MatchContainers
+		*out = new(MatchContainers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MatchPod != nil {
+		in, out := &in.MatchPod, &out.MatchPod
+		*out = new(MatchPod)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PercentageIncrease != nil {
+		in, out := &in.PercentageIncrease, &out.PercentageIncrease
+		*out = new(PercentageIncrease)
+		**out = **in
+	}
+	if in.FixedResources != nil {
+		in, out := &in.FixedResources, &out.FixedResources
+		*out = new(FixedResources)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerPolicy.
+func (in *ContainerPolicy) DeepCopy() *ContainerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DurationOverrideRange) DeepCopyInto(out *DurationOverrideRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DurationOverrideRange.
+func (in *DurationOverrideRange) DeepCopy() *DurationOverrideRange {
+	if in == nil {
+		return nil
+	}
+	out := new(DurationOverrideRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DurationPolicy) DeepCopyInto(out *DurationPolicy) {
+	*out = *in
+	if in.Fixed != nil {
+		in, out := &in.Fixed, &out.Fixed
+		*out = new(FixedDurationPolicy)
+		**out = **in
+	}
+	if in.PodCondition != nil {
+		in, out := &in.PodCondition, &out.PodCondition
+		*out = new(PodConditionDurationPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DurationPolicy.
+func (in *DurationPolicy) DeepCopy() *DurationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DurationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedDurationPolicy) DeepCopyInto(out *FixedDurationPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedDurationPolicy.
+func (in *FixedDurationPolicy) DeepCopy() *FixedDurationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedDurationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FixedResources) DeepCopyInto(out *FixedResources) {
+	*out = *in
+	out.Requests = in.Requests.DeepCopy()
+	out.Limits = in.Limits.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FixedResources.
+func (in *FixedResources) DeepCopy() *FixedResources {
+	if in == nil {
+		return nil
+	}
+	out := new(FixedResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchContainers) DeepCopyInto(out *MatchContainers) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]MatchContainersRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]MatchContainersRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchContainers.
+func (in *MatchContainers) DeepCopy() *MatchContainers {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchContainers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchContainersRule) DeepCopyInto(out *MatchContainersRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchContainersRule.
+func (in *MatchContainersRule) DeepCopy() *MatchContainersRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchContainersRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchPod) DeepCopyInto(out *MatchPod) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PriorityClassNames != nil {
+		in, out := &in.PriorityClassNames, &out.PriorityClassNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OwnerKinds != nil {
+		in, out := &in.OwnerKinds, &out.OwnerKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelectorKeys != nil {
+		in, out := &in.NodeSelectorKeys, &out.NodeSelectorKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchPod.
+func (in *MatchPod) DeepCopy() *MatchPod {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverridePolicy) DeepCopyInto(out *OverridePolicy) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(PercentageOverrideRange)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(DurationOverrideRange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverridePolicy.
+func (in *OverridePolicy) DeepCopy() *OverridePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OverridePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PercentageIncrease) DeepCopyInto(out *PercentageIncrease) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PercentageIncrease.
+func (in *PercentageIncrease) DeepCopy() *PercentageIncrease {
+	if in == nil {
+		return nil
+	}
+	out := new(PercentageIncrease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PercentageOverrideRange) DeepCopyInto(out *PercentageOverrideRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PercentageOverrideRange.
+func (in *PercentageOverrideRange) DeepCopy() *PercentageOverrideRange {
+	if in == nil {
+		return nil
+	}
+	out := new(PercentageOverrideRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodConditionDurationPolicy) DeepCopyInto(out *PodConditionDurationPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodConditionDurationPolicy.
+func (in *PodConditionDurationPolicy) DeepCopy() *PodConditionDurationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PodConditionDurationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileReference) DeepCopyInto(out *ProfileReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileReference.
+func (in *ProfileReference) DeepCopy() *ProfileReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicy) DeepCopyInto(out *ResourcePolicy) {
+	*out = *in
+	if in.ContainerPolicies != nil {
+		in, out := &in.ContainerPolicies, &out.ContainerPolicies
+		*out = make([]ContainerPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicy.
+func (in *ResourcePolicy) DeepCopy() *ResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SkippedContainerBoosts) DeepCopyInto(out *SkippedContainerBoosts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SkippedContainerBoosts.
+func (in *SkippedContainerBoosts) DeepCopy() *SkippedContainerBoosts {
+	if in == nil {
+		return nil
+	}
+	out := new(SkippedContainerBoosts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupCPUBoost) DeepCopyInto(out *StartupCPUBoost) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupCPUBoost.
+func (in *StartupCPUBoost) DeepCopy() *StartupCPUBoost {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupCPUBoost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StartupCPUBoost) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupCPUBoostList) DeepCopyInto(out *StartupCPUBoostList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StartupCPUBoost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupCPUBoostList.
+func (in *StartupCPUBoostList) DeepCopy() *StartupCPUBoostList {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupCPUBoostList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StartupCPUBoostList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupCPUBoostProfile) DeepCopyInto(out *StartupCPUBoostProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupCPUBoostProfile.
+func (in *StartupCPUBoostProfile) DeepCopy() *StartupCPUBoostProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupCPUBoostProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StartupCPUBoostProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupCPUBoostProfileList) DeepCopyInto(out *StartupCPUBoostProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StartupCPUBoostProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupCPUBoostProfileList.
+func (in *StartupCPUBoostProfileList) DeepCopy() *StartupCPUBoostProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupCPUBoostProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StartupCPUBoostProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupCPUBoostProfileSpec) DeepCopyInto(out *StartupCPUBoostProfileSpec) {
+	*out = *in
+	in.ResourcePolicy.DeepCopyInto(&out.ResourcePolicy)
+	in.DurationPolicy.DeepCopyInto(&out.DurationPolicy)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupCPUBoostProfileSpec.
+func (in *StartupCPUBoostProfileSpec) DeepCopy() *StartupCPUBoostProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupCPUBoostProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupCPUBoostSpec) DeepCopyInto(out *StartupCPUBoostSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	in.ResourcePolicy.DeepCopyInto(&out.ResourcePolicy)
+	in.DurationPolicy.DeepCopyInto(&out.DurationPolicy)
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(autoscalingv1.CrossVersionObjectReference)
+		**out = **in
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(OverridePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProfileRef != nil {
+		in, out := &in.ProfileRef, &out.ProfileRef
+		*out = new(ProfileReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupCPUBoostSpec.
+func (in *StartupCPUBoostSpec) DeepCopy() *StartupCPUBoostSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupCPUBoostSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupCPUBoostStatus) DeepCopyInto(out *StartupCPUBoostStatus) {
+	*out = *in
+	if in.SkippedContainerBoosts != nil {
+		in, out := &in.SkippedContainerBoosts, &out.SkippedContainerBoosts
+		*out = make([]SkippedContainerBoosts, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActivePods != nil {
+		in, out := &in.ActivePods, &out.ActivePods
+		*out = make([]ActivePodBoost, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraCPU != nil {
+		in, out := &in.ExtraCPU, &out.ExtraCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DryRunExtraCPU != nil {
+		in, out := &in.DryRunExtraCPU, &out.DryRunExtraCPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupCPUBoostStatus.
+func (in *StartupCPUBoostStatus) DeepCopy() *StartupCPUBoostStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupCPUBoostStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -29,11 +30,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	autoscalingv1alpha1 "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	autoscalingv1beta1 "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	"github.com/google/kube-startup-cpu-boost/internal/boost"
 	"github.com/google/kube-startup-cpu-boost/internal/config"
 	"github.com/google/kube-startup-cpu-boost/internal/controller"
@@ -60,6 +63,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(autoscalingv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(autoscalingv1beta1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -136,22 +140,37 @@ func main() {
 	}
 
 	boostMgr := boost.NewManager(mgr.GetClient())
+	ownerResolver := boost.NewOwnerResolver(mgr.GetAPIReader())
+	profileResolver := boost.NewProfileResolver(mgr.GetClient())
+	eventRecorder := mgr.GetEventRecorder("kube-startup-cpu-boost")
 	crdSync := boost.NewCRDSynchronizer(boost.CRDSynchronizerConfig{
 		Client:                   mgr.GetClient(),
 		Cache:                    mgr.GetCache(),
 		Manager:                  boostMgr,
+		EventRecorder:            eventRecorder,
 		LegacyRevertMode:         controller.ShouldUseLegacyRevertMode(versionInfo.GitVersion),
 		PodLevelResourcesEnabled: podLevelResourcesEnabled,
 		RemoveLimitsEnabled:      cfg.RemoveLimits,
+		OwnerResolver:            ownerResolver,
+		ProfileResolver:          profileResolver,
 		Elected:                  mgr.Elected(),
 	})
 	if err := mgr.Add(crdSync); err != nil {
 		setupLog.Error(err, "unable to add CRD synchronizer to controller-runtime manager")
 		os.Exit(1)
 	}
+	if cfg.WebhookNamespaceScoping {
+		scoper := util.NewWebhookScoper(mgr.GetClient(), mgr.GetAPIReader(), cfg.MutatingWebhookName,
+			boostWebhook.PodCPUBoostWebhookName, boostMgr.RegularCPUBoostNamespaces, crdSync.HasSynced,
+			time.Duration(cfg.MgrCheckIntervalSec)*time.Second)
+		if err := mgr.Add(scoper); err != nil {
+			setupLog.Error(err, "unable to add webhook scoper to controller-runtime manager")
+			os.Exit(1)
+		}
+	}
 	controllersReady := make(chan struct{})
-	go setupControllers(mgr, boostMgr, cfg, podLevelResourcesEnabled, versionInfo.GitVersion, certsReady,
-		controllersReady)
+	go setupControllers(mgr, boostMgr, ownerResolver, profileResolver, eventRecorder, cfg,
+		podLevelResourcesEnabled, versionInfo.GitVersion, certsReady, controllersReady)
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -170,9 +189,9 @@ func main() {
 	}
 }
 
-func setupControllers(mgr ctrl.Manager, boostMgr boost.Manager, cfg *config.Config,
-	podLevelResourcesEnabled bool, serverVersion string, certsReady chan struct{},
-	controllersReady chan struct{}) {
+func setupControllers(mgr ctrl.Manager, boostMgr boost.Manager, ownerResolver boost.OwnerResolver,
+	profileResolver boost.ProfileResolver, eventRecorder events.EventRecorder, cfg *config.Config, podLevelResourcesEnabled bool, serverVersion string,
+	certsReady chan struct{}, controllersReady chan struct{}) {
 	defer close(controllersReady)
 	setupLog.Info("Waiting for certificate generation to complete")
 	<-certsReady
@@ -182,21 +201,33 @@ func setupControllers(mgr ctrl.Manager, boostMgr boost.Manager, cfg *config.Conf
 		setupLog.Error(err, "Unable to create webhook", "webhook", failedWebhook)
 		os.Exit(1)
 	}
-	cpuBoostWebHook := boostWebhook.NewPodCPUBoostWebHook(boostMgr, scheme)
+	cpuBoostWebHook := boostWebhook.NewPodCPUBoostWebHook(boostMgr, mgr.GetClient(), scheme)
 	mgr.GetWebhookServer().Register("/mutate-v1-pod", cpuBoostWebHook)
 	boostCtrl := &controller.StartupCPUBoostReconciler{
 		Client:                   mgr.GetClient(),
 		Scheme:                   mgr.GetScheme(),
 		Log:                      ctrl.Log.WithName("boost-reconciler"),
 		Manager:                  boostMgr,
+		EventRecorder:            eventRecorder,
 		PodLevelResourcesEnabled: podLevelResourcesEnabled,
 		RemoveLimitsEnabled:      cfg.RemoveLimits,
+		OwnerResolver:            ownerResolver,
+		ProfileResolver:          profileResolver,
 	}
 	boostMgr.SetStartupCPUBoostReconciler(boostCtrl)
 	if err := boostCtrl.SetupWithManager(mgr, serverVersion); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "StartupCPUBoost")
 		os.Exit(1)
 	}
+	profileCtrl := &controller.StartupCPUBoostProfileReconciler{
+		Client:  mgr.GetClient(),
+		Log:     ctrl.Log.WithName("profile-reconciler"),
+		Manager: boostMgr,
+	}
+	if err := profileCtrl.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "StartupCPUBoostProfile")
+		os.Exit(1)
+	}
 	//+kubebuilder:scaffold:builder
 }
 
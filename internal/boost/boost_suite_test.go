@@ -18,7 +18,7 @@ import (
 	"testing"
 	"time"
 
-	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
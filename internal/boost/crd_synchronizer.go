@@ -21,8 +21,9 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
-	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,9 +44,12 @@ type crdSynchronizerImpl struct {
 	cache                    ctrlcache.Cache
 	client                   client.Client
 	mgr                      Manager
+	recorder                 events.EventRecorder
 	legacyRevertMode         bool
 	podLevelResourcesEnabled bool
 	removeLimitsEnabled      bool
+	ownerResolver            OwnerResolver
+	profileResolver          ProfileResolver
 	elected                  <-chan struct{}
 	informer                 ctrlcache.Informer
 	log                      logr.Logger
@@ -56,9 +60,12 @@ type CRDSynchronizerConfig struct {
 	Client                   client.Client
 	Cache                    ctrlcache.Cache
 	Manager                  Manager
+	EventRecorder            events.EventRecorder
 	LegacyRevertMode         bool
 	PodLevelResourcesEnabled bool
 	RemoveLimitsEnabled      bool
+	OwnerResolver            OwnerResolver
+	ProfileResolver          ProfileResolver
 	Elected                  <-chan struct{}
 }
 
@@ -68,9 +75,12 @@ func NewCRDSynchronizer(cfg CRDSynchronizerConfig) CRDSynchronizer {
 		client:                   cfg.Client,
 		cache:                    cfg.Cache,
 		mgr:                      cfg.Manager,
+		recorder:                 cfg.EventRecorder,
 		legacyRevertMode:         cfg.LegacyRevertMode,
 		podLevelResourcesEnabled: cfg.PodLevelResourcesEnabled,
 		removeLimitsEnabled:      cfg.RemoveLimitsEnabled,
+		ownerResolver:            cfg.OwnerResolver,
+		profileResolver:          cfg.ProfileResolver,
 		elected:                  cfg.Elected,
 		log:                      ctrl.Log.WithName("crd-synchronizer"),
 	}
@@ -136,15 +146,23 @@ func (c *crdSynchronizerImpl) onAdd(obj any) {
 	log.V(5).Info("handling boost add from informer")
 	boostCfg := &StartupCPUBoostConfig{
 		Client:                   c.client,
+		EventRecorder:            c.recorder,
 		LegacyRevertMode:         c.legacyRevertMode,
 		PodLevelResourcesEnabled: c.podLevelResourcesEnabled,
 		RemoveLimitsEnabled:      c.removeLimitsEnabled,
+		OwnerResolver:            c.ownerResolver,
+		ProfileResolver:          c.profileResolver,
 	}
-	boost, err := NewStartupCPUBoost(boostObj, boostCfg)
+	profileCtx, cancel := context.WithTimeout(context.Background(), ProfileResolutionTimeout)
+	defer cancel()
+	boost, err := NewStartupCPUBoost(profileCtx, boostObj, boostCfg)
 	if err != nil {
 		log.Error(err, "boost creation error")
 		return
 	}
+	if err := boost.ProfileError(); err != nil {
+		log.Error(err, "boost profile resolution error, new PODs are not boosted until it is resolved")
+	}
 	if err := c.mgr.AddRegularCPUBoost(context.Background(), boost); err != nil {
 		if errors.Is(err, ErrStartupCPUBoostAlreadyExists) {
 			log.V(5).Info("boost already registered, updating")
@@ -18,7 +18,7 @@ import (
 	"context"
 	"time"
 
-	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	cpuboost "github.com/google/kube-startup-cpu-boost/internal/boost"
 	"github.com/google/kube-startup-cpu-boost/internal/mock"
 	. "github.com/onsi/ginkgo/v2"
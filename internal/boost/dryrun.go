@@ -0,0 +1,110 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boost
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	"github.com/google/kube-startup-cpu-boost/internal/metrics"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// applyDryRun describes the boost of a POD recorded in a given boost annotation
+// with the dry-run annotation on the POD. The POD resources are not changed. The POD
+// is labelled until the would-be boost is accounted once the POD is created.
+func (b *StartupCPUBoostImpl) applyDryRun(pod *corev1.Pod, annotation *bpod.BoostPodAnnotation,
+	skipped map[string]string, qosClass corev1.PodQOSClass) {
+	if len(annotation.ResourcePolicies) == 0 && len(skipped) == 0 {
+		return
+	}
+	for name, reason := range skipped {
+		annotation.UpdateSkippedContainer(name, reason)
+	}
+	b.RLock()
+	annotation.BoostName = b.name
+	annotation.BoostGeneration = b.generation
+	b.RUnlock()
+	annotation.BoostTimestamp = time.Now()
+	bpod.NewDryRunAnnotation(annotation, qosClass).Apply(pod)
+	label := &bpod.BoostPodLabel{BoostName: b.Name()}
+	label.Apply(pod)
+}
+
+// handleDryRunPodEvent accounts the would-be boost of a POD evaluated in the DryRun
+// mode in the usage statistics and records the events. The POD is accounted once it
+// is created, so the dry-run admission requests and rejected PODs are not accounted.
+// The POD is not tracked, as its resources are not reverted, and its boost label is
+// removed once accounted, so its events are no longer received.
+func (b *StartupCPUBoostImpl) handleDryRunPodEvent(ctx context.Context, event *bpod.PodEvent) error {
+	b.Lock()
+	defer b.Unlock()
+	pod := event.Pod
+	if event.Type == bpod.PodEventTypePodDeleted {
+		delete(b.dryRunPods, pod.UID)
+		return nil
+	}
+	if !b.dryRunPods[pod.UID] {
+		if err := b.accountDryRunPod(ctx, pod); err != nil {
+			return err
+		}
+		b.dryRunPods[pod.UID] = true
+	}
+	// the POD is remembered until its label is removed, so it is not accounted
+	// again when the removal fails
+	if err := b.removeBoostLabel(ctx, pod); err != nil {
+		return fmt.Errorf("failed to remove boost label: %w", err)
+	}
+	delete(b.dryRunPods, pod.UID)
+	return nil
+}
+
+// accountDryRunPod accounts the would-be boost of a POD evaluated in the DryRun mode
+// in the usage statistics and records the events
+func (b *StartupCPUBoostImpl) accountDryRunPod(ctx context.Context, pod *corev1.Pod) error {
+	annotation, err := bpod.DryRunAnnotationFromPod(pod)
+	if err != nil {
+		return fmt.Errorf("failed to parse dry-run annotation: %w", err)
+	}
+	if annotation.Timestamp.Before(b.dryRunSeedTime) {
+		// PODs evaluated before the seed are already counted in the seeded stats
+		return nil
+	}
+	b.loggerFromContext(ctx).V(5).Info("accounting dry-run pod", "pod", pod.Name)
+	boosted := slices.Sorted(maps.Keys(annotation.ResourcePolicies))
+	if len(boosted) > 0 {
+		// the resources are recorded from the quantities, so they are always parsable
+		extraCPU, _ := annotation.ExtraCPURequests()
+		b.stats.DryRunContainerBoosts += len(boosted)
+		b.stats.DryRunExtraCPU.Add(extraCPU)
+		metrics.AddDryRunContainers(b.namespace, b.name, float64(len(boosted)))
+		b.recordEvent(pod, corev1.EventTypeNormal, EventReasonDryRun, EventActionBoost,
+			"CPU resources of containers %s would be boosted by %s CPU requests, keeping the %s QoS class",
+			strings.Join(boosted, ", "), extraCPU.String(), annotation.QOSClass)
+	}
+	for _, name := range slices.Sorted(maps.Keys(annotation.SkippedContainers)) {
+		reason := annotation.SkippedContainers[name]
+		b.stats.SkippedContainerBoosts[reason]++
+		metrics.AddSkippedContainers(b.namespace, b.name, reason, 1)
+		b.recordEvent(pod, corev1.EventTypeNormal, reason, EventActionBoost,
+			"Container %s was not boosted: %s", name, skippedContainerReasons[reason])
+	}
+	return nil
+}
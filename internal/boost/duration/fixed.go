@@ -50,6 +50,20 @@ func (p *FixedDurationPolicy) Duration() time.Duration {
 	return p.duration
 }
 
+// WithDuration returns a copy of the policy with a given duration
+func (p *FixedDurationPolicy) WithDuration(duration time.Duration) Policy {
+	return NewFixedDurationPolicyWithTimeFunc(p.timeFunc, duration)
+}
+
+func (p *FixedDurationPolicy) Deadline(pod *v1.Pod) (time.Time, bool) {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionTrue {
+			return condition.LastTransitionTime.Add(p.duration), true
+		}
+	}
+	return time.Time{}, false
+}
+
 func (p *FixedDurationPolicy) Valid(pod *v1.Pod) bool {
 	now := p.timeFunc()
 	for _, condition := range pod.Status.Conditions {
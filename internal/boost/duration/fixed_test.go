@@ -71,4 +71,44 @@ var _ = Describe("FixedDurationPolicy", func() {
 			})
 		})
 	})
+	Describe("Determines the POD deadline", func() {
+		When("the POD is not scheduled", func() {
+			It("returns deadline is unknown", func() {
+				pod.Status.Conditions = []v1.PodCondition{}
+				_, ok := policy.Deadline(pod)
+				Expect(ok).To(BeFalse())
+			})
+		})
+		When("the POD is scheduled", func() {
+			It("returns schedule time increased by the policy duration", func() {
+				scheduleTime := now.Add(-1 * time.Minute)
+				pod.Status.Conditions = []v1.PodCondition{
+					{
+						LastTransitionTime: metav1.NewTime(scheduleTime),
+						Type:               v1.PodScheduled,
+						Status:             v1.ConditionTrue,
+					}}
+				deadline, ok := policy.Deadline(pod)
+				Expect(ok).To(BeTrue())
+				Expect(deadline).To(BeTemporally("==", scheduleTime.Add(timeDuration)))
+			})
+		})
+	})
+	Describe("Copies the policy with a given duration", func() {
+		It("returns the policy with the duration and the time function", func() {
+			fixedPolicy, ok := policy.(*duration.FixedDurationPolicy)
+			Expect(ok).To(BeTrue())
+			copied := fixedPolicy.WithDuration(2 * time.Minute)
+			Expect(copied.(*duration.FixedDurationPolicy).Duration()).To(Equal(2 * time.Minute))
+			Expect(fixedPolicy.Duration()).To(Equal(timeDuration))
+			scheduleTime := now.Add(-1 * time.Minute)
+			pod.Status.Conditions = []v1.PodCondition{
+				{
+					LastTransitionTime: metav1.NewTime(scheduleTime),
+					Type:               v1.PodScheduled,
+					Status:             v1.ConditionTrue,
+				}}
+			Expect(copied.Valid(pod)).To(BeTrue())
+		})
+	})
 })
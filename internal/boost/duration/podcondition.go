@@ -15,6 +15,8 @@
 package duration
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -46,6 +48,15 @@ func (p *PodConditionPolicy) Status() corev1.ConditionStatus {
 	return p.status
 }
 
+func (p *PodConditionPolicy) Deadline(pod *corev1.Pod) (time.Time, bool) {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == p.condition && condition.Status == p.status {
+			return condition.LastTransitionTime.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
 func (p *PodConditionPolicy) Valid(pod *corev1.Pod) bool {
 	for _, condition := range pod.Status.Conditions {
 		if condition.Type != p.condition {
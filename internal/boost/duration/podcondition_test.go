@@ -15,10 +15,13 @@
 package duration_test
 
 import (
+	"time"
+
 	"github.com/google/kube-startup-cpu-boost/internal/boost/duration"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("PodConditionPolicy", func() {
@@ -63,4 +66,26 @@ var _ = Describe("PodConditionPolicy", func() {
 			})
 		})
 	})
+	Describe("Determines the POD deadline", func() {
+		When("condition status does not match status in a policy", func() {
+			It("returns deadline is unknown", func() {
+				pod.Status.Conditions = []corev1.PodCondition{{Type: condition, Status: corev1.ConditionFalse}}
+				_, ok := policy.Deadline(pod)
+				Expect(ok).To(BeFalse())
+			})
+		})
+		When("condition status matches status in a policy", func() {
+			It("returns condition transition time", func() {
+				transitionTime := time.Now().Add(-1 * time.Minute)
+				pod.Status.Conditions = []corev1.PodCondition{{
+					Type:               condition,
+					Status:             status,
+					LastTransitionTime: metav1.NewTime(transitionTime),
+				}}
+				deadline, ok := policy.Deadline(pod)
+				Expect(ok).To(BeTrue())
+				Expect(deadline).To(BeTemporally("==", transitionTime))
+			})
+		})
+	})
 })
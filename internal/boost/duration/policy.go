@@ -15,7 +15,11 @@
 // Package duration contains implementation of resource boost duration policies
 package duration
 
-import corev1 "k8s.io/api/core/v1"
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
 
 const (
 	PolicyTypeFixed        = "Fixed"
@@ -25,4 +29,7 @@ const (
 type Policy interface {
 	Valid(pod *corev1.Pod) bool
 	Name() string
+	// Deadline returns the time when the policy becomes not valid for a given POD,
+	// if such time is known
+	Deadline(pod *corev1.Pod) (time.Time, bool)
 }
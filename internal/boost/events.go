@@ -0,0 +1,79 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boost
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Reasons of the events recorded on PODs and StartupCPUBoosts
+const (
+	EventReasonBoosted               = "Boosted"
+	EventReasonSkippedQoSChange      = "SkippedQoSChange"
+	EventReasonSkippedRestartPolicy  = "SkippedRestartPolicy"
+	EventReasonSkippedNoCPUResources = "SkippedNoCPUResources"
+	EventReasonReverted              = "Reverted"
+	EventReasonRevertFailed          = "RevertFailed"
+	EventReasonRevertSkipped         = "RevertSkipped"
+	EventReasonDryRun                = "DryRun"
+	EventActionBoost                 = "Boost"
+	EventActionRevert                = "Revert"
+)
+
+// skippedContainerReasons maps the reasons of skipping the container boost
+// recorded in the POD annotation to the event notes
+var skippedContainerReasons = map[string]string{
+	EventReasonSkippedQoSChange:      "resource increase would change the POD QoS class",
+	EventReasonSkippedRestartPolicy:  "CPU resize requires container restart",
+	EventReasonSkippedNoCPUResources: "container has no CPU resources to increase",
+}
+
+// recordEvent records an event on a given POD and on the StartupCPUBoost
+func (b *StartupCPUBoostImpl) recordEvent(pod *corev1.Pod, eventType, reason, action, note string,
+	args ...interface{}) {
+	if b.recorder == nil {
+		return
+	}
+	b.recorder.Eventf(pod, b.object, eventType, reason, action, note, args...)
+	b.recorder.Eventf(b.object, pod, eventType, reason, action, note, args...)
+}
+
+// recordBoostedEvents records the events describing the boost of a newly created POD.
+// PODs that are no longer pending were boosted before they were tracked, i.e. prior
+// to the controller restart, so the events are not recorded again.
+func (b *StartupCPUBoostImpl) recordBoostedEvents(pod *corev1.Pod) {
+	if pod.Status.Phase != "" && pod.Status.Phase != corev1.PodPending {
+		return
+	}
+	annotation, err := bpod.BoostAnnotationFromPod(pod)
+	if err != nil {
+		return
+	}
+	boosted := make(map[string]string)
+	maps.Copy(boosted, annotation.InitCPURequests)
+	maps.Copy(boosted, annotation.InitCPULimits)
+	b.recordEvent(pod, corev1.EventTypeNormal, EventReasonBoosted, EventActionBoost,
+		"CPU resources of containers %s were boosted", strings.Join(slices.Sorted(maps.Keys(boosted)), ", "))
+	for _, name := range slices.Sorted(maps.Keys(annotation.SkippedContainers)) {
+		reason := annotation.SkippedContainers[name]
+		b.recordEvent(pod, corev1.EventTypeNormal, reason, EventActionBoost,
+			"Container %s was not boosted: %s", name, skippedContainerReasons[reason])
+	}
+}
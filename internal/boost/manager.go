@@ -18,10 +18,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
-	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -58,9 +59,18 @@ type Manager interface {
 	GetRegularCPUBoost(ctx context.Context, name, namespace string) (StartupCPUBoost, bool)
 
 	// GetCPUBoostForPod returns a startup cpu boost that matches a given pod if such is registered
-	// in a manager. If multiple boost types matches, the most specific is returned.
+	// in a manager. If multiple boost types matches, the most specific is returned. No boost
+	// is returned when the matching one is suspended.
 	GetCPUBoostForPod(ctx context.Context, pod *corev1.Pod) (StartupCPUBoost, bool)
 
+	// ConflictingCPUBoosts returns the sorted names of regular startup cpu boosts in a given
+	// namespace which selectors overlap with the selector of a boost with a given name.
+	ConflictingCPUBoosts(ctx context.Context, name, namespace string) []string
+
+	// RegularCPUBoostNamespaces returns the sorted names of namespaces with at least one
+	// regular startup cpu boost registered in a manager.
+	RegularCPUBoostNamespaces(ctx context.Context) []string
+
 	// HandlePodEvent handles the POD event.
 	// If found, the matching cpu boost is returned.
 	HandlePodEvent(ctx context.Context, event *bpod.PodEvent) (StartupCPUBoost, error)
@@ -119,6 +129,9 @@ type managerImpl struct {
 	regularBoosts namespacedObjects[StartupCPUBoost]
 	// orphanedPods is a collection of tracked pods that have no matching boost registered
 	orphanedPods namespacedObjects[*corev1.Pod]
+	// generation is increased on every change of the regular boosts, so the POD boost
+	// resolved outside of the lock can be verified against the changes done meanwhile
+	generation uint64
 }
 
 func NewManager(client client.Client) Manager {
@@ -151,6 +164,7 @@ func (m *managerImpl) AddRegularCPUBoost(ctx context.Context, boost StartupCPUBo
 	defer m.postProcessNewBoost(ctx, boost)
 	log.V(5).Info("handling regular boost registration")
 	m.regularBoosts.Put(boost.Name(), boost.Namespace(), boost)
+	m.generation++
 	metrics.NewBoostConfiguration(boost.Namespace())
 	return nil
 }
@@ -164,6 +178,7 @@ func (m *managerImpl) DeleteRegularCPUBoost(ctx context.Context, namespace, name
 	defer log.Info("boost deleted successfully")
 	m.regularBoosts.Delete(name, namespace)
 	m.timedBoosts.Delete(name, namespace)
+	m.generation++
 	metrics.DeleteBoostConfiguration(namespace)
 }
 
@@ -183,6 +198,7 @@ func (m *managerImpl) UpdateRegularCPUBoost(ctx context.Context,
 	if err := boost.UpdateFromSpec(ctx, spec); err != nil {
 		return err
 	}
+	m.generation++
 	m.postProcessNewBoost(ctx, boost)
 	return nil
 }
@@ -197,20 +213,71 @@ func (m *managerImpl) GetRegularCPUBoost(ctx context.Context, name string,
 }
 
 // GetCPUBoostForPod returns a startup cpu boost that matches a given pod if such is registered
-// in a manager. If multiple boost types matches, the most specific is returned.
+// in a manager. If multiple boost types matches, the most specific is returned. No boost
+// is returned when the matching one is suspended or its referenced profile is not resolved.
+// The boosts are matched outside of the
+// manager lock, as resolving the POD controllers may require API calls.
 func (m *managerImpl) GetCPUBoostForPod(ctx context.Context,
 	pod *corev1.Pod) (StartupCPUBoost, bool) {
 	m.RLock()
+	candidates := m.regularBoosts.List(pod.Namespace)
+	m.RUnlock()
+	boost, ok := matchingBoost(ctx, candidates, pod)
+	if ok && boost.Suspended() {
+		// the suspended boost still matches the POD so its events are handled by
+		// the boost, but no other boost is applied instead
+		m.log.V(5).Info("matching boost is suspended", "boost", boost.Name(), "namespace", boost.Namespace())
+		return nil, false
+	}
+	if ok && boost.ProfileError() != nil {
+		m.log.V(5).Info("matching boost profile is not resolved", "boost", boost.Name(),
+			"namespace", boost.Namespace())
+		return nil, false
+	}
+	return boost, ok
+}
+
+// ConflictingCPUBoosts returns the sorted names of regular startup cpu boosts in a given
+// namespace which selectors or target references overlap with the ones of a boost with
+// a given name.
+func (m *managerImpl) ConflictingCPUBoosts(ctx context.Context, name,
+	namespace string) []string {
+	m.RLock()
 	defer m.RUnlock()
-	return m.getMatchingBoost(pod)
+	boost, ok := m.regularBoosts.Get(name, namespace)
+	if !ok {
+		return nil
+	}
+	var conflicting []string
+	for _, other := range m.regularBoosts.List(namespace) {
+		if other.Name() == name {
+			continue
+		}
+		if TargetsOverlap(boost.Selector(), boost.TargetRef(), other.Selector(), other.TargetRef()) {
+			conflicting = append(conflicting, other.Name())
+		}
+	}
+	slices.Sort(conflicting)
+	return conflicting
+}
+
+// RegularCPUBoostNamespaces returns the sorted names of namespaces with at least one
+// regular startup cpu boost registered in a manager.
+func (m *managerImpl) RegularCPUBoostNamespaces(ctx context.Context) []string {
+	m.RLock()
+	defer m.RUnlock()
+	namespaces := m.regularBoosts.Namespaces()
+	slices.Sort(namespaces)
+	return namespaces
 }
 
 // HandlePodEvent handles the POD event.
-// If found, the matching cpu boost is returned.
+// If found, the matching cpu boost is returned. The events of a boosted POD are
+// handled by the boost that boosted it, so a boost with a higher priority registered
+// later does not take over the POD. The POD boost is resolved outside of the manager
+// lock, as resolving the POD controllers may require API calls, and resolved again
+// when the boosts were changed before the lock was acquired.
 func (m *managerImpl) HandlePodEvent(ctx context.Context, event *bpod.PodEvent) (StartupCPUBoost, error) {
-	m.Lock()
-	defer m.Unlock()
-
 	if err := event.Validate(); err != nil {
 		return nil, err
 	}
@@ -218,7 +285,17 @@ func (m *managerImpl) HandlePodEvent(ctx context.Context, event *bpod.PodEvent)
 	pod := event.Pod
 	m.log.V(5).Info("handling pod event", "type", event.Type)
 
-	if boost, ok := m.getMatchingBoost(pod); ok {
+	boost, ok, generation := m.resolvePodBoost(ctx, pod)
+	m.Lock()
+	for generation != m.generation {
+		m.Unlock()
+		m.log.V(5).Info("boosts changed while resolving pod boost, resolving again")
+		boost, ok, generation = m.resolvePodBoost(ctx, pod)
+		m.Lock()
+	}
+	defer m.Unlock()
+
+	if ok {
 		err := boost.HandlePodEvent(ctx, event)
 		if err == nil {
 			m.orphanedPods.Delete(pod.Name, pod.Namespace)
@@ -251,6 +328,7 @@ func (m *managerImpl) Start(ctx context.Context) error {
 		select {
 		case <-m.ticker.Tick():
 			m.log.V(5).Info("tick...")
+			m.resolveBoostProfiles(ctx)
 			m.validateTimePolicyBoosts(ctx)
 		case <-ctx.Done():
 			m.log.Info("stopping")
@@ -269,15 +347,62 @@ func (m *managerImpl) setRunning(isRunning bool) {
 	m.isRunning = isRunning
 }
 
-// getMatchingBoost finds the most specific matching boost for a given pod.
-func (m *managerImpl) getMatchingBoost(pod *corev1.Pod) (StartupCPUBoost, bool) {
-	namespaceBoosts := m.regularBoosts.List(pod.Namespace)
-	for _, boost := range namespaceBoosts {
-		if boost.Matches(pod) {
-			return boost, true
+// matchingBoost finds the matching boost for a given pod among the given boosts. When
+// multiple boosts match, the one with the highest priority is returned and boosts with
+// equal priority are ordered by name, so the result does not depend on the map order.
+func matchingBoost(ctx context.Context, boosts []StartupCPUBoost, pod *corev1.Pod) (StartupCPUBoost, bool) {
+	var matching StartupCPUBoost
+	for _, boost := range boosts {
+		if !boost.Matches(ctx, pod) {
+			continue
+		}
+		if matching == nil || precedes(boost, matching) {
+			matching = boost
 		}
 	}
-	return nil, false
+	return matching, matching != nil
+}
+
+// resolvePodBoost finds the boost handling the events of a given POD, along with the
+// generation of the boosts it was resolved from. The POD is handled by the boost
+// recorded in its boost label or annotation, the matching boost is looked up only for
+// the POD without such record. The boosts are matched outside of the manager lock.
+func (m *managerImpl) resolvePodBoost(ctx context.Context, pod *corev1.Pod) (StartupCPUBoost, bool, uint64) {
+	m.RLock()
+	generation := m.generation
+	if name, ok := podBoostName(pod); ok {
+		boost, found := m.regularBoosts.Get(name, pod.Namespace)
+		m.RUnlock()
+		return boost, found, generation
+	}
+	candidates := m.regularBoosts.List(pod.Namespace)
+	m.RUnlock()
+	boost, ok := matchingBoost(ctx, candidates, pod)
+	return boost, ok, generation
+}
+
+// podBoostName returns the name of the boost that boosted a given POD, as recorded
+// in the POD boost label or, if missing, in the POD boost annotation
+func podBoostName(pod *corev1.Pod) (string, bool) {
+	if name := pod.Labels[bpod.BoostLabelKey]; name != "" {
+		return name, true
+	}
+	if _, ok := pod.Annotations[bpod.BoostAnnotationKey]; !ok {
+		return "", false
+	}
+	annotation, err := bpod.BoostAnnotationFromPod(pod)
+	if err != nil || annotation.BoostName == "" {
+		return "", false
+	}
+	return annotation.BoostName, true
+}
+
+// precedes determines if boost a takes precedence over boost b when both match a POD
+func precedes(a, b StartupCPUBoost) bool {
+	if a.Priority() != b.Priority() {
+		return a.Priority() > b.Priority()
+	}
+	return a.Name() < b.Name()
 }
 
 // postProcessNewBoost performs additional post processing of a newly registered boost
@@ -292,15 +417,23 @@ func (m *managerImpl) postProcessNewBoost(ctx context.Context, boost StartupCPUB
 	}
 }
 
-// mapOrphanedPods maps orphaned pods to the given boost if they match.
-// Matched pods are registered in a boost and removed from orphanedPods collection.
+// mapOrphanedPods maps orphaned pods to the given boost if they match. The pods boosted
+// by the given boost are matched regardless of its selector and the pods boosted by
+// other boosts are never matched. Matched pods are registered in a boost and removed
+// from orphanedPods collection.
 func (m *managerImpl) mapOrphanedPods(ctx context.Context, boost StartupCPUBoost) error {
 	log := m.log.WithValues("boost", boost.Name(), "namespace", boost.Namespace())
 	errs := make([]error, 0)
 	namespaceOrphanedPods := m.orphanedPods.List(boost.Namespace())
 	mappedOrphanedPods := make([]*corev1.Pod, 0, len(namespaceOrphanedPods))
 	for _, orphanedPod := range namespaceOrphanedPods {
-		if boost.Matches(orphanedPod) {
+		var matches bool
+		if name, ok := podBoostName(orphanedPod); ok {
+			matches = name == boost.Name()
+		} else {
+			matches = boost.Matches(ctx, orphanedPod)
+		}
+		if matches {
 			log := log.WithValues("pod", orphanedPod.Name)
 			log.V(5).Info("matched orphaned pod")
 			if err := boost.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodCreated, Pod: orphanedPod}); err != nil {
@@ -316,6 +449,37 @@ func (m *managerImpl) mapOrphanedPods(ctx context.Context, boost StartupCPUBoost
 	return errors.Join(errs...)
 }
 
+// resolveBoostProfiles resolves again the profiles of the boosts which referenced profile
+// is not resolved, so the boosts boost new PODs once their profiles are resolved.
+func (m *managerImpl) resolveBoostProfiles(ctx context.Context) {
+	m.RLock()
+	var unresolved []StartupCPUBoost
+	for _, boost := range m.regularBoosts.ListAll() {
+		if boost.ProfileError() != nil {
+			unresolved = append(unresolved, boost)
+		}
+	}
+	m.RUnlock()
+
+	for _, boost := range unresolved {
+		log := m.log.WithValues("boost", boost.Name(), "namespace", boost.Namespace())
+		profileCtx, cancel := context.WithTimeout(ctx, ProfileResolutionTimeout)
+		err := boost.ResolveProfile(profileCtx)
+		cancel()
+		if err != nil {
+			log.V(5).Info("boost profile is not resolved", "error", err.Error())
+			continue
+		}
+		log.Info("boost profile resolved")
+		m.Lock()
+		if registered, ok := m.regularBoosts.Get(boost.Name(), boost.Namespace()); ok && registered == boost {
+			m.generation++
+			m.postProcessNewBoost(ctx, boost)
+		}
+		m.Unlock()
+	}
+}
+
 // validateTimePolicyBoosts validates all time policy boosts in a manager
 // and reverts the resources for violated pods.
 func (m *managerImpl) validateTimePolicyBoosts(ctx context.Context) {
@@ -18,7 +18,7 @@ import (
 	"context"
 	"time"
 
-	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	cpuboost "github.com/google/kube-startup-cpu-boost/internal/boost"
 	"github.com/google/kube-startup-cpu-boost/internal/boost/duration"
 	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
@@ -27,8 +27,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -59,7 +63,7 @@ var _ = Describe("Manager", func() {
 
 		BeforeEach(func() {
 			var err error
-			boost, err = cpuboost.NewStartupCPUBoost(spec, config)
+			boost, err = cpuboost.NewStartupCPUBoost(context.Background(), spec, config)
 			Expect(err).To(Succeed())
 		})
 
@@ -90,10 +94,10 @@ var _ = Describe("Manager", func() {
 				BeforeEach(func() {
 					pod = podTemplate.DeepCopy()
 					pod.Labels["app.kubernetes.io/name"] = "app-001"
-					spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+					spec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
 
 					var err error
-					boost, err = cpuboost.NewStartupCPUBoost(spec, config)
+					boost, err = cpuboost.NewStartupCPUBoost(context.Background(), spec, config)
 					Expect(err).To(Succeed())
 				})
 
@@ -121,7 +125,7 @@ var _ = Describe("Manager", func() {
 		When("startup-cpu-boost exists", func() {
 			It("removes the startup-cpu-boost and updates metrics", func(ctx context.Context) {
 				manager := cpuboost.NewManager(nil)
-				boost, err := cpuboost.NewStartupCPUBoost(spec, config)
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 				Expect(err).To(Succeed())
 				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
 
@@ -148,7 +152,7 @@ var _ = Describe("Manager", func() {
 		When("startup-cpu-boost is registered", func() {
 			It("updates the startup-cpu-boost", func(ctx context.Context) {
 				manager := cpuboost.NewManager(nil)
-				boost, err := cpuboost.NewStartupCPUBoost(spec, config)
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 				Expect(err).To(Succeed())
 				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
 
@@ -186,8 +190,8 @@ var _ = Describe("Manager", func() {
 
 		When("matching startup-cpu-boost exists", func() {
 			It("returns true and valid boost", func(ctx context.Context) {
-				spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
-				boost, err := cpuboost.NewStartupCPUBoost(spec, config)
+				spec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 				Expect(err).To(Succeed())
 
 				manager := cpuboost.NewManager(nil)
@@ -200,6 +204,191 @@ var _ = Describe("Manager", func() {
 				Expect(foundBoost.Namespace()).To(Equal(spec.Namespace))
 			})
 		})
+
+		When("matching startup-cpu-boost is suspended", func() {
+			It("returns false and nil boost", func(ctx context.Context) {
+				spec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				spec.Spec.Suspend = true
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).To(Succeed())
+
+				manager := cpuboost.NewManager(nil)
+				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+
+				foundBoost, found := manager.GetCPUBoostForPod(ctx, pod)
+				Expect(found).To(BeFalse())
+				Expect(foundBoost).To(BeNil())
+			})
+			It("does not return other matching boost with lower priority", func(ctx context.Context) {
+				manager := cpuboost.NewManager(nil)
+				for name, suspend := range map[string]bool{"boost-001": true, "boost-002": false} {
+					boostSpec := spec.DeepCopy()
+					boostSpec.Name = name
+					boostSpec.Spec.Suspend = suspend
+					boostSpec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{},
+						"app.kubernetes.io/name", "app-001")
+					boost, err := cpuboost.NewStartupCPUBoost(ctx, boostSpec, config)
+					Expect(err).To(Succeed())
+					Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+				}
+
+				_, found := manager.GetCPUBoostForPod(ctx, pod)
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		When("matching startup-cpu-boost has target reference", func() {
+			var (
+				manager cpuboost.Manager
+				boost   cpuboost.StartupCPUBoost
+			)
+			BeforeEach(func() {
+				controller := true
+				pod.OwnerReferences = []metav1.OwnerReference{
+					{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "demo-rs", UID: "demo-rs-uid", Controller: &controller},
+				}
+				spec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				spec.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "demo",
+				}
+				config.OwnerResolver = cpuboost.NewOwnerResolver(mockClient)
+				var err error
+				boost, err = cpuboost.NewStartupCPUBoost(context.Background(), spec, config)
+				Expect(err).To(Succeed())
+				manager = cpuboost.NewManager(nil)
+				Expect(manager.AddRegularCPUBoost(context.Background(), boost)).To(Succeed())
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(client.ObjectKey{Namespace: pod.Namespace, Name: "demo-rs"}),
+					gomock.Any()).DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj client.Object,
+					opts ...client.GetOption) error {
+					// the manager lock is acquired while the owners are resolved
+					locked := make(chan error, 1)
+					go func() { locked <- manager.AddRegularCPUBoost(ctx, boost) }()
+					Eventually(locked).Should(Receive(MatchError(cpuboost.ErrStartupCPUBoostAlreadyExists)))
+					obj.SetOwnerReferences([]metav1.OwnerReference{
+						{APIVersion: "apps/v1", Kind: "Deployment", Name: "demo", UID: "demo-uid", Controller: &controller},
+					})
+					return nil
+				}).Times(1)
+			})
+			It("resolves the POD owners without holding the manager lock", func(ctx context.Context) {
+				foundBoost, found := manager.GetCPUBoostForPod(ctx, pod)
+				Expect(found).To(BeTrue())
+				Expect(foundBoost).To(Equal(boost))
+			})
+		})
+
+		When("multiple matching startup-cpu-boosts exist", func() {
+			var manager cpuboost.Manager
+			var priorities map[string]int32
+
+			BeforeEach(func() {
+				priorities = map[string]int32{"boost-003": 0, "boost-002": 0, "boost-001": 0}
+			})
+
+			JustBeforeEach(func(ctx context.Context) {
+				manager = cpuboost.NewManager(nil)
+				for name, priority := range priorities {
+					boostSpec := spec.DeepCopy()
+					boostSpec.Name = name
+					boostSpec.Spec.Priority = priority
+					boostSpec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{},
+						"app.kubernetes.io/name", "app-001")
+					boost, err := cpuboost.NewStartupCPUBoost(ctx, boostSpec, config)
+					Expect(err).To(Succeed())
+					Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+				}
+			})
+
+			When("boosts have equal priority", func() {
+				It("returns the first boost by name", func(ctx context.Context) {
+					for range 10 {
+						foundBoost, found := manager.GetCPUBoostForPod(ctx, pod)
+						Expect(found).To(BeTrue())
+						Expect(foundBoost.Name()).To(Equal("boost-001"))
+					}
+				})
+			})
+
+			When("boosts have different priority", func() {
+				BeforeEach(func() {
+					priorities["boost-003"] = 10
+					priorities["boost-002"] = 10
+					priorities["boost-001"] = -5
+				})
+				It("returns the boost with the highest priority", func(ctx context.Context) {
+					for range 10 {
+						foundBoost, found := manager.GetCPUBoostForPod(ctx, pod)
+						Expect(found).To(BeTrue())
+						Expect(foundBoost.Name()).To(Equal("boost-002"))
+					}
+				})
+			})
+		})
+	})
+
+	Describe("RegularCPUBoostNamespaces", func() {
+		var manager cpuboost.Manager
+
+		BeforeEach(func(ctx context.Context) {
+			manager = cpuboost.NewManager(nil)
+			for name, namespace := range map[string]string{"boost-001": "ns-002", "boost-002": "ns-001",
+				"boost-003": "ns-002"} {
+				boostSpec := spec.DeepCopy()
+				boostSpec.Name = name
+				boostSpec.Namespace = namespace
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, boostSpec, config)
+				Expect(err).To(Succeed())
+				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+			}
+		})
+
+		It("returns the sorted namespaces with registered boosts", func(ctx context.Context) {
+			Expect(manager.RegularCPUBoostNamespaces(ctx)).To(Equal([]string{"ns-001", "ns-002"}))
+		})
+
+		When("the last boost in a namespace is deleted", func() {
+			It("does not return the namespace", func(ctx context.Context) {
+				manager.DeleteRegularCPUBoost(ctx, "ns-001", "boost-002")
+				Expect(manager.RegularCPUBoostNamespaces(ctx)).To(Equal([]string{"ns-002"}))
+			})
+		})
+	})
+
+	Describe("ConflictingCPUBoosts", func() {
+		var manager cpuboost.Manager
+
+		BeforeEach(func(ctx context.Context) {
+			manager = cpuboost.NewManager(nil)
+			for name, value := range map[string]string{"boost-001": "app-001", "boost-002": "app-001",
+				"boost-003": "app-002"} {
+				boostSpec := spec.DeepCopy()
+				boostSpec.Name = name
+				boostSpec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", value)
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, boostSpec, config)
+				Expect(err).To(Succeed())
+				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+			}
+		})
+
+		When("startup-cpu-boost selector overlaps with other boosts", func() {
+			It("returns the names of overlapping boosts", func(ctx context.Context) {
+				Expect(manager.ConflictingCPUBoosts(ctx, "boost-001", spec.Namespace)).To(Equal([]string{"boost-002"}))
+			})
+		})
+
+		When("startup-cpu-boost selector does not overlap with other boosts", func() {
+			It("returns empty result", func(ctx context.Context) {
+				Expect(manager.ConflictingCPUBoosts(ctx, "boost-003", spec.Namespace)).To(BeEmpty())
+			})
+		})
+
+		When("startup-cpu-boost is not registered", func() {
+			It("returns empty result", func(ctx context.Context) {
+				Expect(manager.ConflictingCPUBoosts(ctx, "boost-004", spec.Namespace)).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("UpsertPod", func() {
@@ -213,16 +402,117 @@ var _ = Describe("Manager", func() {
 		When("there is a matching boost", func() {
 			It("returns valid matched boost without error", func(ctx context.Context) {
 				boostSpec := specTemplate.DeepCopy()
-				boostSpec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
-				boost, err := cpuboost.NewStartupCPUBoost(boostSpec, config)
+				boostSpec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, boostSpec, config)
+				Expect(err).To(Succeed())
+
+				manager := cpuboost.NewManager(nil)
+				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+
+				matchedBoost, err := manager.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodCreated, Pod: pod})
+				Expect(err).To(Succeed())
+				Expect(matchedBoost).To(Equal(boost))
+			})
+		})
+
+		When("the matching boost is suspended", func() {
+			It("returns the suspended boost tracking the POD", func(ctx context.Context) {
+				boostSpec := specTemplate.DeepCopy()
+				boostSpec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				boostSpec.Spec.Suspend = true
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, boostSpec, config)
+				Expect(err).To(Succeed())
+
+				manager := cpuboost.NewManager(nil)
+				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+
+				matchedBoost, err := manager.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodCreated, Pod: pod})
+				Expect(err).To(Succeed())
+				Expect(matchedBoost).To(Equal(boost))
+				_, ok := boost.Pod(pod.Name)
+				Expect(ok).To(BeTrue())
+			})
+		})
+
+		When("the pod was boosted by other boost than the one with the highest priority", func() {
+			It("returns the boost that boosted the pod", func(ctx context.Context) {
+				boostSpec := specTemplate.DeepCopy()
+				boostSpec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, boostSpec, config)
+				Expect(err).To(Succeed())
+				otherSpec := boostSpec.DeepCopy()
+				otherSpec.Name = "boost-002"
+				otherSpec.Spec.Priority = 10
+				other, err := cpuboost.NewStartupCPUBoost(ctx, otherSpec, config)
 				Expect(err).To(Succeed())
 
 				manager := cpuboost.NewManager(nil)
 				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+				Expect(manager.AddRegularCPUBoost(ctx, other)).To(Succeed())
 
 				matchedBoost, err := manager.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodCreated, Pod: pod})
 				Expect(err).To(Succeed())
 				Expect(matchedBoost).To(Equal(boost))
+				_, ok := other.Pod(pod.Name)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		When("the boost that boosted the pod is not registered", func() {
+			It("does not map the orphaned pod to other matching boost", func(ctx context.Context) {
+				otherSpec := specTemplate.DeepCopy()
+				otherSpec.Name = "boost-002"
+				otherSpec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				other, err := cpuboost.NewStartupCPUBoost(ctx, otherSpec, config)
+				Expect(err).To(Succeed())
+
+				manager := cpuboost.NewManager(nil)
+				Expect(manager.AddRegularCPUBoost(ctx, other)).To(Succeed())
+
+				matchedBoost, err := manager.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodCreated, Pod: pod})
+				Expect(err).To(Succeed())
+				Expect(matchedBoost).To(BeNil())
+				Expect(manager.UpdateRegularCPUBoost(ctx, otherSpec)).To(Succeed())
+				_, ok := other.Pod(pod.Name)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		When("the matching boost has target reference", func() {
+			It("resolves the POD owners without holding the manager lock", func(ctx context.Context) {
+				controller := true
+				pod.OwnerReferences = []metav1.OwnerReference{
+					{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "demo-rs", UID: "demo-rs-uid", Controller: &controller},
+				}
+				boostSpec := specTemplate.DeepCopy()
+				boostSpec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				boostSpec.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "demo",
+				}
+				delete(pod.Labels, bpod.BoostLabelKey)
+				delete(pod.Annotations, bpod.BoostAnnotationKey)
+				config.OwnerResolver = cpuboost.NewOwnerResolver(mockClient)
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, boostSpec, config)
+				Expect(err).To(Succeed())
+				manager := cpuboost.NewManager(nil)
+				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(client.ObjectKey{Namespace: pod.Namespace, Name: "demo-rs"}),
+					gomock.Any()).DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj client.Object,
+					opts ...client.GetOption) error {
+					locked := make(chan error, 1)
+					go func() { locked <- manager.AddRegularCPUBoost(ctx, boost) }()
+					Eventually(locked).Should(Receive(MatchError(cpuboost.ErrStartupCPUBoostAlreadyExists)))
+					obj.SetOwnerReferences([]metav1.OwnerReference{
+						{APIVersion: "apps/v1", Kind: "Deployment", Name: "demo", UID: "demo-uid", Controller: &controller},
+					})
+					return nil
+				}).Times(1)
+
+				matchedBoost, err := manager.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodDeleted, Pod: pod})
+				Expect(err).To(Succeed())
+				Expect(matchedBoost).To(Equal(boost))
 			})
 		})
 
@@ -247,8 +537,8 @@ var _ = Describe("Manager", func() {
 		When("there is a matching boost", func() {
 			It("removes the pod from the matched boost", func(ctx context.Context) {
 				boostSpec := specTemplate.DeepCopy()
-				boostSpec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
-				boost, err := cpuboost.NewStartupCPUBoost(boostSpec, config)
+				boostSpec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, boostSpec, config)
 				Expect(err).To(Succeed())
 
 				manager := cpuboost.NewManager(nil)
@@ -312,6 +602,47 @@ var _ = Describe("Manager", func() {
 			})
 		})
 
+		When("There are startup-cpu-boosts with unresolved profile", func() {
+			It("resolves the profile and returns the boost for the POD", func(ctx context.Context) {
+				pod := podTemplate.DeepCopy()
+				pod.Labels["app.kubernetes.io/name"] = "app-001"
+				spec.Spec.Selector = *metav1.AddLabelToSelector(&metav1.LabelSelector{}, "app.kubernetes.io/name", "app-001")
+				spec.Spec.ProfileRef = &autoscaling.ProfileReference{Name: "jvm"}
+				config.ProfileResolver = cpuboost.NewProfileResolver(mockClient)
+				profileKey := client.ObjectKey{Namespace: spec.Namespace, Name: "jvm"}
+				gomock.InOrder(
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(profileKey), gomock.Any()).
+						Return(apierrors.NewNotFound(schema.GroupResource{Resource: "startupcpuboostprofiles"}, "jvm")).
+						Times(1),
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(profileKey), gomock.Any()).Return(nil).Times(1),
+				)
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).To(Succeed())
+				Expect(boost.ProfileError()).To(HaveOccurred())
+
+				manager = cpuboost.NewManagerWithTicker(nil, mockTicker)
+				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
+				_, found := manager.GetCPUBoostForPod(ctx, pod)
+				Expect(found).To(BeFalse())
+
+				startCtx, cancel := context.WithCancel(ctx)
+				done := make(chan struct{})
+				go func() {
+					defer GinkgoRecover()
+					Expect(manager.Start(startCtx)).To(Succeed())
+					close(done)
+				}()
+				c <- time.Now()
+				Eventually(func() bool {
+					_, found := manager.GetCPUBoostForPod(ctx, pod)
+					return found
+				}).Should(BeTrue())
+
+				cancel()
+				<-done
+			})
+		})
+
 		When("There are startup-cpu-boosts with fixed duration policy", func() {
 			var (
 				pod             *corev1.Pod
@@ -325,7 +656,7 @@ var _ = Describe("Manager", func() {
 				manager = cpuboost.NewManagerWithTicker(nil, mockTicker)
 				manager.SetStartupCPUBoostReconciler(mockReconciler)
 
-				boost, err := cpuboost.NewStartupCPUBoost(spec, config)
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 				Expect(err).To(Succeed())
 				Expect(boost.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodCreated, Pod: pod})).To(Succeed())
 				Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
@@ -377,9 +708,9 @@ var _ = Describe("Manager", func() {
 
 					runWithTickAndRevert(ctx, func(patchCalled chan struct{}) {
 						mockSubResourceClient := mock.NewMockSubResourceClient(mockCtrl)
-						mockSubResourceClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
-							gomock.Eq(bpod.NewRevertBootsResourcesPatch())).
-							DoAndReturn(func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, false)),
+							gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+							DoAndReturn(func(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
 								select {
 								case patchCalled <- struct{}{}:
 								default:
@@ -388,8 +719,6 @@ var _ = Describe("Manager", func() {
 							}).Times(1)
 
 						mockClient.EXPECT().SubResource("resize").Return(mockSubResourceClient).Times(1)
-						mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
-							gomock.Eq(bpod.NewRevertBoostLabelsPatch())).Return(nil).Times(1)
 					})
 				})
 			})
@@ -429,9 +758,9 @@ var _ = Describe("Manager", func() {
 
 					patchCalled := make(chan struct{}, 1)
 					mockSubResourceClient := mock.NewMockSubResourceClient(mockCtrl)
-					mockSubResourceClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
-						gomock.Eq(bpod.NewRevertBootsResourcesPatch())).
-						DoAndReturn(func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, false)),
+						gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+						DoAndReturn(func(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
 							select {
 							case patchCalled <- struct{}{}:
 							default:
@@ -440,13 +769,11 @@ var _ = Describe("Manager", func() {
 						}).Times(1)
 
 					mockClient.EXPECT().SubResource("resize").Return(mockSubResourceClient).Times(1)
-					mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
-						gomock.Eq(bpod.NewRevertBoostLabelsPatch())).Return(nil).Times(1)
 
 					manager = cpuboost.NewManagerWithTicker(nil, mockTicker)
 					manager.SetStartupCPUBoostReconciler(mockReconciler)
 
-					boost, err := cpuboost.NewStartupCPUBoost(spec, config)
+					boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 					Expect(err).To(Succeed())
 					Expect(boost.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodCreated, Pod: pod})).To(Succeed())
 					Expect(manager.AddRegularCPUBoost(ctx, boost)).To(Succeed())
@@ -485,9 +812,9 @@ var _ = Describe("Manager", func() {
 
 					runWithTickAndRevert(ctx, func(patchCalled chan struct{}) {
 						mockSubResourceClient := mock.NewMockSubResourceClient(mockCtrl)
-						mockSubResourceClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
-							gomock.Eq(bpod.NewRevertBootsResourcesPatch())).
-							DoAndReturn(func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, false)),
+							gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+							DoAndReturn(func(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption) error {
 								select {
 								case patchCalled <- struct{}{}:
 								default:
@@ -496,8 +823,6 @@ var _ = Describe("Manager", func() {
 							}).Times(1)
 
 						mockClient.EXPECT().SubResource("resize").Return(mockSubResourceClient).Times(1)
-						mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
-							gomock.Eq(bpod.NewRevertBoostLabelsPatch())).Return(nil).Times(1)
 					})
 				})
 			})
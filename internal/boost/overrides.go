@@ -0,0 +1,110 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boost
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
+	"github.com/google/kube-startup-cpu-boost/internal/boost/duration"
+	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// podOverrides holds the POD and namespace annotation overrides that are within
+// the bounds of the boost override policy
+type podOverrides struct {
+	percentage *int64
+	duration   *time.Duration
+}
+
+// allowedOverrides returns the overrides that are within the bounds of the boost
+// override policy and the warnings explaining why the other overrides are ignored
+func (b *StartupCPUBoostImpl) allowedOverrides(overrides bpod.BoostOverrides) (podOverrides, []string) {
+	b.RLock()
+	defer b.RUnlock()
+	var result podOverrides
+	warnings := slices.Clone(overrides.Warnings)
+	var percentageRange *autoscaling.PercentageOverrideRange
+	var durationRange *autoscaling.DurationOverrideRange
+	if b.overridePolicy != nil {
+		percentageRange = b.overridePolicy.Percentage
+		durationRange = b.overridePolicy.Duration
+	}
+	if percentage := overrides.Percentage; percentage != nil {
+		switch {
+		case percentageRange == nil:
+			warnings = append(warnings, fmt.Sprintf(
+				"percentage override %d is ignored as it is not allowed by the boost", *percentage))
+		case *percentage < percentageRange.Min || *percentage > percentageRange.Max:
+			warnings = append(warnings, fmt.Sprintf(
+				"percentage override %d is ignored as it is outside of the allowed range [%d, %d]",
+				*percentage, percentageRange.Min, percentageRange.Max))
+		default:
+			result.percentage = percentage
+		}
+	}
+	if d := overrides.Duration; d != nil {
+		_, hasFixedPolicy := b.durationPolicies[duration.FixedDurationPolicyName]
+		switch {
+		case durationRange == nil:
+			warnings = append(warnings, fmt.Sprintf(
+				"duration override %s is ignored as it is not allowed by the boost", d))
+		case !hasFixedPolicy:
+			warnings = append(warnings, fmt.Sprintf(
+				"duration override %s is ignored as the boost has no fixed duration policy", d))
+		default:
+			minDuration := fixedPolicyToDuration(autoscaling.FixedDurationPolicy{
+				Unit: durationRange.Unit, Value: durationRange.Min})
+			maxDuration := fixedPolicyToDuration(autoscaling.FixedDurationPolicy{
+				Unit: durationRange.Unit, Value: durationRange.Max})
+			if *d < minDuration || *d > maxDuration {
+				warnings = append(warnings, fmt.Sprintf(
+					"duration override %s is ignored as it is outside of the allowed range [%s, %s]",
+					d, minDuration, maxDuration))
+			} else {
+				result.duration = d
+			}
+		}
+	}
+	return result, warnings
+}
+
+// podDurationPolicy returns a given duration policy with the duration overridden
+// for a given POD, if any
+func (b *StartupCPUBoostImpl) podDurationPolicy(p duration.Policy, pod *corev1.Pod) duration.Policy {
+	fixedPolicy, ok := p.(*duration.FixedDurationPolicy)
+	if !ok {
+		return p
+	}
+	if d, ok := b.durationOverrides[pod.Name]; ok {
+		return fixedPolicy.WithDuration(d)
+	}
+	return p
+}
+
+// trackDurationOverride records the fixed duration policy value overridden for
+// a given POD with the annotation
+func (b *StartupCPUBoostImpl) trackDurationOverride(pod *corev1.Pod) {
+	annotation, err := bpod.BoostAnnotationFromPod(pod)
+	if err != nil {
+		return
+	}
+	if d, ok := annotation.FixedDurationOverride(); ok {
+		b.durationOverrides[pod.Name] = d
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boost
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ownerCacheSize is the maximum number of the cached POD owners
+	ownerCacheSize = 4096
+	// ownerCacheTTL is the time after which the cached POD owner is read again,
+	// i.e. to notice the owner adoption
+	ownerCacheTTL = 10 * time.Minute
+	// maxOwnerChainDepth is the maximum number of the controllers followed from the POD
+	maxOwnerChainDepth = 5
+)
+
+//+kubebuilder:rbac:groups=apps,resources=replicasets;deployments;statefulsets;daemonsets,verbs=get
+//+kubebuilder:rbac:groups=batch,resources=jobs;cronjobs,verbs=get
+
+// OwnerResolver resolves the chain of the POD controllers through the controller
+// owner references, i.e. a ReplicaSet and a Deployment controlling a POD
+type OwnerResolver interface {
+	// IsControlledBy determines if a given POD is controlled, directly or through the
+	// chain of controllers, by an object with a given reference
+	IsControlledBy(ctx context.Context, pod *corev1.Pod, target *autoscalingv1.CrossVersionObjectReference) (bool, error)
+}
+
+type ownerResolverImpl struct {
+	reader client.Reader
+	// owners holds the controller owner references, or nil when there is no controller,
+	// of the owner objects keyed by the owner UID
+	owners *cache.LRUExpireCache
+}
+
+// NewOwnerResolver constructs a new OwnerResolver reading the POD owners metadata with a
+// given reader. The controllers of the owners are cached, so the reader is called once
+// for every owner in the cache TTL.
+func NewOwnerResolver(reader client.Reader) OwnerResolver {
+	return &ownerResolverImpl{
+		reader: reader,
+		owners: cache.NewLRUExpireCache(ownerCacheSize),
+	}
+}
+
+// IsControlledBy determines if a given POD is controlled, directly or through the
+// chain of controllers, by an object with a given reference. The API version of
+// the reference is compared only by the group.
+func (r *ownerResolverImpl) IsControlledBy(ctx context.Context, pod *corev1.Pod,
+	target *autoscalingv1.CrossVersionObjectReference) (bool, error) {
+	owner := metav1.GetControllerOfNoCopy(pod)
+	for depth := 0; owner != nil && depth < maxOwnerChainDepth; depth++ {
+		if refersTo(owner, target) {
+			return true, nil
+		}
+		var err error
+		if owner, err = r.controllerOf(ctx, pod.Namespace, owner); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// controllerOf returns the controller owner reference of the owner object with a given
+// reference, or nil when the owner has no controller or does not exist
+func (r *ownerResolverImpl) controllerOf(ctx context.Context, namespace string,
+	owner *metav1.OwnerReference) (*metav1.OwnerReference, error) {
+	if cached, ok := r.owners.Get(owner.UID); ok {
+		return cached.(*metav1.OwnerReference), nil
+	}
+	obj := &metav1.PartialObjectMetadata{}
+	obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(owner.APIVersion, owner.Kind))
+	if err := r.reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: owner.Name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get %s %s: %w", owner.Kind, owner.Name, err)
+	}
+	controller := metav1.GetControllerOf(obj)
+	r.owners.Add(owner.UID, controller, ownerCacheTTL)
+	return controller, nil
+}
+
+// refersTo determines if a given owner reference refers to the object with
+// a given cross version reference
+func refersTo(owner *metav1.OwnerReference, target *autoscalingv1.CrossVersionObjectReference) bool {
+	return sameTarget(&autoscalingv1.CrossVersionObjectReference{
+		APIVersion: owner.APIVersion,
+		Kind:       owner.Kind,
+		Name:       owner.Name,
+	}, target)
+}
+
+// sameTarget determines if the given cross version references refer to the same
+// object, the API versions are compared only by the group
+func sameTarget(a, b *autoscalingv1.CrossVersionObjectReference) bool {
+	if a.Kind != b.Kind || a.Name != b.Name {
+		return false
+	}
+	aGV, err := schema.ParseGroupVersion(a.APIVersion)
+	if err != nil {
+		return false
+	}
+	bGV, err := schema.ParseGroupVersion(b.APIVersion)
+	if err != nil {
+		return false
+	}
+	return aGV.Group == bGV.Group
+}
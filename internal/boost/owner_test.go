@@ -0,0 +1,144 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boost_test
+
+import (
+	"context"
+	"errors"
+
+	cpuboost "github.com/google/kube-startup-cpu-boost/internal/boost"
+	"github.com/google/kube-startup-cpu-boost/internal/mock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("OwnerResolver", func() {
+	var (
+		mockCtrl   *gomock.Controller
+		mockClient *mock.MockClient
+		resolver   cpuboost.OwnerResolver
+		pod        *corev1.Pod
+		target     *autoscalingv1.CrossVersionObjectReference
+		controlled bool
+		err        error
+	)
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClient = mock.NewMockClient(mockCtrl)
+		resolver = cpuboost.NewOwnerResolver(mockClient)
+		controller := true
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "demo-5d8f7b9c4-abcde",
+				Namespace: "demo",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "apps/v1",
+						Kind:       "ReplicaSet",
+						Name:       "demo-5d8f7b9c4",
+						UID:        "rs-uid",
+						Controller: &controller,
+					},
+				},
+			},
+		}
+		target = &autoscalingv1.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "demo",
+		}
+	})
+	JustBeforeEach(func() {
+		controlled, err = resolver.IsControlledBy(context.TODO(), pod, target)
+	})
+	When("target is the direct POD controller", func() {
+		BeforeEach(func() {
+			target = &autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       "demo-5d8f7b9c4",
+			}
+		})
+		It("returns true without reading the controller", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controlled).To(BeTrue())
+		})
+	})
+	When("target controls the POD controller", func() {
+		BeforeEach(func() {
+			controller := true
+			mockClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: "demo", Name: "demo-5d8f7b9c4"},
+				gomock.AssignableToTypeOf(&metav1.PartialObjectMetadata{})).
+				DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					obj.SetOwnerReferences([]metav1.OwnerReference{
+						{APIVersion: "apps/v1", Kind: "Deployment", Name: "demo", UID: "deploy-uid", Controller: &controller},
+					})
+					return nil
+				}).Times(1)
+		})
+		It("returns true", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controlled).To(BeTrue())
+		})
+		It("caches the controller of the POD controller", func() {
+			controlled, err = resolver.IsControlledBy(context.TODO(), pod, target)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controlled).To(BeTrue())
+		})
+	})
+	When("POD controller has no controller", func() {
+		BeforeEach(func() {
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		})
+		It("returns false", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controlled).To(BeFalse())
+		})
+	})
+	When("POD controller does not exist", func() {
+		BeforeEach(func() {
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "replicasets"}, "demo-5d8f7b9c4"))
+		})
+		It("returns false", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controlled).To(BeFalse())
+		})
+	})
+	When("POD controller can't be read", func() {
+		BeforeEach(func() {
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("forbidden"))
+		})
+		It("errors", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+	When("POD has no controller", func() {
+		BeforeEach(func() {
+			pod.OwnerReferences = nil
+		})
+		It("returns false", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controlled).To(BeFalse())
+		})
+	})
+})
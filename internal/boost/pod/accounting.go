@@ -0,0 +1,58 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"fmt"
+
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ExtraCPURequests returns the CPU requests granted by the boost on top of the original
+// CPU requests, summed for all of the boosted containers. Containers without recorded
+// original or boosted CPU requests are not accounted.
+func (a *BoostPodAnnotation) ExtraCPURequests() (apiResource.Quantity, error) {
+	return extraCPURequests(a.InitCPURequests, a.BoostedCPURequests)
+}
+
+// ExtraCPURequests returns the CPU requests that would be granted by the boost on top
+// of the original CPU requests, summed for all of the containers that would be boosted.
+func (a *DryRunPodAnnotation) ExtraCPURequests() (apiResource.Quantity, error) {
+	return extraCPURequests(a.InitCPURequests, a.BoostedCPURequests)
+}
+
+func extraCPURequests(initRequests, boostedRequests map[string]string) (apiResource.Quantity, error) {
+	var result apiResource.Quantity
+	for containerName, boostedValue := range boostedRequests {
+		initValue, ok := initRequests[containerName]
+		if !ok {
+			continue
+		}
+		boosted, err := apiResource.ParseQuantity(boostedValue)
+		if err != nil {
+			return result, fmt.Errorf("failed to parse boosted CPU request: %s", err)
+		}
+		init, err := apiResource.ParseQuantity(initValue)
+		if err != nil {
+			return result, fmt.Errorf("failed to parse init CPU request: %s", err)
+		}
+		if boosted.Cmp(init) <= 0 {
+			continue
+		}
+		result.Add(boosted)
+		result.Sub(init)
+	}
+	return result, nil
+}
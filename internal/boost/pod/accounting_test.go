@@ -0,0 +1,79 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod_test
+
+import (
+	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Accounting", func() {
+	var (
+		annot  *bpod.BoostPodAnnotation
+		result apiResource.Quantity
+		err    error
+	)
+	BeforeEach(func() {
+		annot = &bpod.BoostPodAnnotation{
+			InitCPURequests: map[string]string{
+				containerOneName: "500m",
+				containerTwoName: "1",
+			},
+			BoostedCPURequests: map[string]string{
+				containerOneName: "1",
+				containerTwoName: "2500m",
+			},
+		}
+	})
+	JustBeforeEach(func() {
+		result, err = annot.ExtraCPURequests()
+	})
+	Describe("Returns extra CPU requests", func() {
+		When("containers have original and boosted CPU requests", func() {
+			It("doesn't error", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("returns the sum of the differences", func() {
+				Expect(result.Cmp(apiResource.MustParse("2"))).To(Equal(0))
+			})
+		})
+		When("container has no boosted CPU requests", func() {
+			BeforeEach(func() {
+				delete(annot.BoostedCPURequests, containerTwoName)
+			})
+			It("doesn't account the container", func() {
+				Expect(result.Cmp(apiResource.MustParse("500m"))).To(Equal(0))
+			})
+		})
+		When("container has no original CPU requests", func() {
+			BeforeEach(func() {
+				delete(annot.InitCPURequests, containerOneName)
+			})
+			It("doesn't account the container", func() {
+				Expect(result.Cmp(apiResource.MustParse("1500m"))).To(Equal(0))
+			})
+		})
+		When("boosted CPU requests are invalid", func() {
+			BeforeEach(func() {
+				annot.BoostedCPURequests[containerOneName] = "invalid"
+			})
+			It("errors", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,203 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+const resizeSubResource = "resize"
+
+// CPUResourcesDrifted determines if the CPU resources of any boosted POD container
+// differ from the boosted values recorded in the boost annotation, i.e. as a result
+// of a resize done by another actor during the boost. Containers without recorded
+// boosted values are not considered as drifted.
+func CPUResourcesDrifted(pod *corev1.Pod) (bool, error) {
+	annotation, err := BoostAnnotationFromPod(pod)
+	if err != nil {
+		return false, fmt.Errorf("failed to get boost annotation from pod: %s", err)
+	}
+	for _, container := range pod.Spec.Containers {
+		if !annotation.hasBoostedResources(container.Name) {
+			continue
+		}
+		boostedRequests, boostedLimits, err := annotation.boostedResources(container.Name)
+		if err != nil {
+			return false, err
+		}
+		if container.Resources.Requests.Cpu().Cmp(boostedRequests) != 0 ||
+			container.Resources.Limits.Cpu().Cmp(boostedLimits) != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ResizedByOthers determines if the POD was resized by a field manager other than the
+// given one, i.e. any managed fields entry of the resize sub-resource belongs to another
+// manager. The values set by the POD creator are not tracked on the resize sub-resource.
+func ResizedByOthers(pod *corev1.Pod, fieldManager string) bool {
+	for _, entry := range pod.ManagedFields {
+		if entry.Subresource == resizeSubResource && entry.Manager != fieldManager {
+			return true
+		}
+	}
+	return false
+}
+
+// CPUResourcesReverted determines if the POD spec reflects the reverted CPU resources,
+// i.e. the boosted containers no longer hold the boosted values. For containers without
+// recorded boosted values, the CPU resources have to match the original values.
+func CPUResourcesReverted(pod *corev1.Pod) (bool, error) {
+	annotation, err := BoostAnnotationFromPod(pod)
+	if err != nil {
+		return false, fmt.Errorf("failed to get boost annotation from pod: %s", err)
+	}
+	for _, container := range pod.Spec.Containers {
+		if annotation.hasBoostedResources(container.Name) {
+			boostedRequests, boostedLimits, err := annotation.boostedResources(container.Name)
+			if err != nil {
+				return false, err
+			}
+			if container.Resources.Requests.Cpu().Cmp(boostedRequests) == 0 &&
+				container.Resources.Limits.Cpu().Cmp(boostedLimits) == 0 {
+				return false, nil
+			}
+			continue
+		}
+		if value, ok := annotation.InitCPURequests[container.Name]; ok {
+			request, err := apiResource.ParseQuantity(value)
+			if err != nil {
+				return false, fmt.Errorf("failed to parse CPU request: %s", err)
+			}
+			if container.Resources.Requests.Cpu().Cmp(request) != 0 {
+				return false, nil
+			}
+		}
+		if value, ok := annotation.InitCPULimits[container.Name]; ok {
+			limit, err := apiResource.ParseQuantity(value)
+			if err != nil {
+				return false, fmt.Errorf("failed to parse CPU limit: %s", err)
+			}
+			if container.Resources.Limits.Cpu().Cmp(limit) != 0 {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// RevertResourceBoostDelta reverts the boost resources, labels and annotations preserving
+// the CPU resource changes done by other actors during the boost.
+func RevertResourceBoostDelta(pod *corev1.Pod) error {
+	if err := revertBoostResourcesDelta(pod); err != nil {
+		return err
+	}
+	return revertBoostLabels(pod)
+}
+
+// revertBoostResourcesDelta sets the container CPU resources to their original values
+// increased by the difference between the current and boosted values. Limits added or
+// removed by other actors during the boost are left unchanged.
+func revertBoostResourcesDelta(pod *corev1.Pod) error {
+	annotation, err := BoostAnnotationFromPod(pod)
+	if err != nil {
+		return fmt.Errorf("failed to get boost annotation from pod: %s", err)
+	}
+	live := make(map[string]corev1.ResourceRequirements, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		live[container.Name] = *container.Resources.DeepCopy()
+	}
+	if err := revertBoostResources(pod); err != nil {
+		return err
+	}
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		if !annotation.hasBoostedResources(container.Name) {
+			continue
+		}
+		boostedRequests, boostedLimits, err := annotation.boostedResources(container.Name)
+		if err != nil {
+			return err
+		}
+		liveResources := live[container.Name]
+		if request, ok := container.Resources.Requests[corev1.ResourceCPU]; ok {
+			container.Resources.Requests[corev1.ResourceCPU] = applyDelta(request,
+				*liveResources.Requests.Cpu(), boostedRequests)
+		}
+		_, hadLimit := annotation.InitCPULimits[container.Name]
+		liveLimit, hasLiveLimit := liveResources.Limits[corev1.ResourceCPU]
+		switch {
+		case boostedLimits.IsZero():
+			// limit was removed by the boost, any live limit was set by other actor
+			if hasLiveLimit {
+				if container.Resources.Limits == nil {
+					container.Resources.Limits = corev1.ResourceList{}
+				}
+				container.Resources.Limits[corev1.ResourceCPU] = liveLimit
+			}
+		case !hasLiveLimit:
+			delete(container.Resources.Limits, corev1.ResourceCPU)
+		case hadLimit:
+			container.Resources.Limits[corev1.ResourceCPU] = applyDelta(
+				container.Resources.Limits[corev1.ResourceCPU], liveLimit, boostedLimits)
+		}
+		if limit, ok := container.Resources.Limits[corev1.ResourceCPU]; ok {
+			if request, ok := container.Resources.Requests[corev1.ResourceCPU]; ok && request.Cmp(limit) > 0 {
+				container.Resources.Requests[corev1.ResourceCPU] = limit
+			}
+		}
+	}
+	return nil
+}
+
+// applyDelta returns the original value increased by the difference between the live
+// and boosted values. The live value is returned when the result is not positive.
+func applyDelta(original, live, boosted apiResource.Quantity) apiResource.Quantity {
+	result := original.DeepCopy()
+	result.Add(live)
+	result.Sub(boosted)
+	if result.Sign() <= 0 {
+		return live
+	}
+	return result
+}
+
+// hasBoostedResources determines if the annotation holds the boosted values
+// for a container with a given name
+func (a *BoostPodAnnotation) hasBoostedResources(containerName string) bool {
+	_, hasRequests := a.BoostedCPURequests[containerName]
+	_, hasLimits := a.BoostedCPULimits[containerName]
+	return hasRequests || hasLimits
+}
+
+// boostedResources returns the boosted CPU requests and limits of a container with
+// a given name. Zero quantity is returned for values that were not set by the boost.
+func (a *BoostPodAnnotation) boostedResources(containerName string) (requests, limits apiResource.Quantity, err error) {
+	if value, ok := a.BoostedCPURequests[containerName]; ok {
+		if requests, err = apiResource.ParseQuantity(value); err != nil {
+			return requests, limits, fmt.Errorf("failed to parse boosted CPU request: %s", err)
+		}
+	}
+	if value, ok := a.BoostedCPULimits[containerName]; ok {
+		if limits, err = apiResource.ParseQuantity(value); err != nil {
+			return requests, limits, fmt.Errorf("failed to parse boosted CPU limit: %s", err)
+		}
+	}
+	return
+}
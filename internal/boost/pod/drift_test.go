@@ -0,0 +1,223 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod_test
+
+import (
+	"encoding/json"
+	"time"
+
+	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Drift", func() {
+	var (
+		annot *bpod.BoostPodAnnotation
+		pod   *corev1.Pod
+	)
+	BeforeEach(func() {
+		annot = &bpod.BoostPodAnnotation{
+			State:          bpod.BoostStateActive,
+			BoostTimestamp: time.Now(),
+			InitCPURequests: map[string]string{
+				containerOneName: "500m",
+				containerTwoName: "500m",
+			},
+			InitCPULimits: map[string]string{
+				containerOneName: "1",
+				containerTwoName: "1",
+			},
+			BoostedCPURequests: map[string]string{
+				containerOneName: "1",
+				containerTwoName: "1",
+			},
+			BoostedCPULimits: map[string]string{
+				containerOneName: "2",
+				containerTwoName: "2",
+			},
+		}
+		pod = podTemplate.DeepCopy()
+	})
+	JustBeforeEach(func() {
+		annot.Apply(pod)
+	})
+	Describe("Determines if POD CPU resources drifted", func() {
+		When("POD is missing startup-cpu-boost annotation", func() {
+			It("returns an error", func() {
+				delete(pod.Annotations, bpod.BoostAnnotationKey)
+				_, err := bpod.CPUResourcesDrifted(pod)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		When("annotation has no boosted values", func() {
+			BeforeEach(func() {
+				annot.BoostedCPURequests = nil
+				annot.BoostedCPULimits = nil
+				pod.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU] = apiResource.MustParse("3")
+			})
+			It("returns false", func() {
+				Expect(bpod.CPUResourcesDrifted(pod)).To(BeFalse())
+			})
+		})
+		When("resources are equal to boosted values", func() {
+			It("returns false", func() {
+				Expect(bpod.CPUResourcesDrifted(pod)).To(BeFalse())
+			})
+		})
+		When("limits were removed during boost", func() {
+			BeforeEach(func() {
+				annot.BoostedCPULimits = nil
+				pod.Spec.Containers[0].Resources.Limits = nil
+				pod.Spec.Containers[1].Resources.Limits = nil
+			})
+			It("returns false", func() {
+				Expect(bpod.CPUResourcesDrifted(pod)).To(BeFalse())
+			})
+		})
+		When("requests were changed", func() {
+			BeforeEach(func() {
+				pod.Spec.Containers[1].Resources.Requests[corev1.ResourceCPU] = apiResource.MustParse("1500m")
+			})
+			It("returns true", func() {
+				Expect(bpod.CPUResourcesDrifted(pod)).To(BeTrue())
+			})
+		})
+		When("limits were added after removal during boost", func() {
+			BeforeEach(func() {
+				annot.BoostedCPULimits = nil
+				pod.Spec.Containers[1].Resources.Limits = nil
+			})
+			It("returns true", func() {
+				Expect(bpod.CPUResourcesDrifted(pod)).To(BeTrue())
+			})
+		})
+	})
+	Describe("Determines if POD was resized by other field managers", func() {
+		BeforeEach(func() {
+			pod.ManagedFields = []metav1.ManagedFieldsEntry{
+				{Manager: "kubectl-create", Operation: metav1.ManagedFieldsOperationUpdate},
+				{Manager: "kube-startup-cpu-boost", Operation: metav1.ManagedFieldsOperationApply,
+					Subresource: "resize"},
+			}
+		})
+		It("returns false when resized by the given field manager only", func() {
+			Expect(bpod.ResizedByOthers(pod, "kube-startup-cpu-boost")).To(BeFalse())
+		})
+		It("returns true when resized by another field manager", func() {
+			pod.ManagedFields = append(pod.ManagedFields, metav1.ManagedFieldsEntry{
+				Manager: "vpa-updater", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "resize"})
+			Expect(bpod.ResizedByOthers(pod, "kube-startup-cpu-boost")).To(BeTrue())
+		})
+	})
+	Describe("Determines if POD CPU resources were reverted", func() {
+		When("resources are equal to boosted values", func() {
+			It("returns false", func() {
+				Expect(bpod.CPUResourcesReverted(pod)).To(BeFalse())
+			})
+		})
+		When("resources differ from boosted values", func() {
+			BeforeEach(func() {
+				for i := range pod.Spec.Containers {
+					pod.Spec.Containers[i].Resources.Requests[corev1.ResourceCPU] = apiResource.MustParse("500m")
+					pod.Spec.Containers[i].Resources.Limits[corev1.ResourceCPU] = apiResource.MustParse("1")
+				}
+			})
+			It("returns true", func() {
+				Expect(bpod.CPUResourcesReverted(pod)).To(BeTrue())
+			})
+		})
+		When("annotation has no boosted values", func() {
+			BeforeEach(func() {
+				annot.BoostedCPURequests = nil
+				annot.BoostedCPULimits = nil
+			})
+			It("returns false when resources differ from original values", func() {
+				Expect(bpod.CPUResourcesReverted(pod)).To(BeFalse())
+			})
+			It("returns true when resources are equal to original values", func() {
+				for i := range pod.Spec.Containers {
+					pod.Spec.Containers[i].Resources.Requests[corev1.ResourceCPU] = apiResource.MustParse("500m")
+					pod.Spec.Containers[i].Resources.Limits[corev1.ResourceCPU] = apiResource.MustParse("1")
+				}
+				Expect(bpod.CPUResourcesReverted(pod)).To(BeTrue())
+			})
+		})
+	})
+	Describe("Reverts the POD container resources delta", func() {
+		DescribeTable("reverts pod metadata and boost increase of the resources",
+			func(mutate func(pod *corev1.Pod, annot *bpod.BoostPodAnnotation), request, limit string) {
+				mutate(pod, annot)
+				annot.Apply(pod)
+
+				Expect(bpod.RevertResourceBoostDelta(pod)).To(Succeed())
+
+				expectPodMetadataReverted(pod)
+				resources := pod.Spec.Containers[0].Resources
+				Expect(resources.Requests.Cpu().String()).To(Equal(request))
+				if limit == "" {
+					Expect(resources.Limits).NotTo(HaveKey(corev1.ResourceCPU))
+				} else {
+					Expect(resources.Limits.Cpu().String()).To(Equal(limit))
+				}
+			},
+			Entry("no drift",
+				func(pod *corev1.Pod, annot *bpod.BoostPodAnnotation) {}, "500m", "1"),
+			Entry("requests increased",
+				func(pod *corev1.Pod, annot *bpod.BoostPodAnnotation) {
+					pod.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU] = apiResource.MustParse("1500m")
+				}, "1", "1"),
+			Entry("requests decreased below the increase",
+				func(pod *corev1.Pod, annot *bpod.BoostPodAnnotation) {
+					pod.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU] = apiResource.MustParse("400m")
+				}, "400m", "1"),
+			Entry("limits increased",
+				func(pod *corev1.Pod, annot *bpod.BoostPodAnnotation) {
+					pod.Spec.Containers[0].Resources.Limits[corev1.ResourceCPU] = apiResource.MustParse("3")
+				}, "500m", "2"),
+			Entry("limits removed",
+				func(pod *corev1.Pod, annot *bpod.BoostPodAnnotation) {
+					pod.Spec.Containers[0].Resources.Limits = nil
+				}, "500m", ""),
+			Entry("limits added after removal during boost",
+				func(pod *corev1.Pod, annot *bpod.BoostPodAnnotation) {
+					annot.BoostedCPULimits = nil
+					pod.Spec.Containers[0].Resources.Limits[corev1.ResourceCPU] = apiResource.MustParse("3")
+				}, "500m", "3"),
+			Entry("limits removed during boost",
+				func(pod *corev1.Pod, annot *bpod.BoostPodAnnotation) {
+					annot.BoostedCPULimits = nil
+					pod.Spec.Containers[0].Resources.Limits = nil
+				}, "500m", "1"),
+		)
+	})
+	Describe("Creates revert boost resources delta apply configuration", func() {
+		It("returns valid apply configuration", func() {
+			pod.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU] = apiResource.MustParse("1500m")
+			annot.Apply(pod)
+			applyConfig, err := bpod.RevertBoostResourcesApplyConfiguration(pod, true)
+			Expect(err).NotTo(HaveOccurred())
+			applyConfigData, err := json.Marshal(applyConfig.Spec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(applyConfigData)).To(Equal(
+				"{\"containers\":[{\"name\":\"container-one\",\"resources\":{\"limits\":{\"cpu\":\"1\"}," +
+					"\"requests\":{\"cpu\":\"1\"}}},{\"name\":\"container-two\",\"resources\":{\"limits\":{\"cpu\":\"1\"}," +
+					"\"requests\":{\"cpu\":\"500m\"}}}]}"))
+		})
+	})
+})
@@ -0,0 +1,95 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DryRunAnnotationKey is the key of the POD annotation describing the boost
+// that would be applied by the StartupCPUBoost in the DryRun mode
+const DryRunAnnotationKey = "autoscaling.x-k8s.io/startup-cpu-boost-dry-run"
+
+// DryRunPodAnnotation describes the boost that would be applied on a POD by the
+// StartupCPUBoost in the DryRun mode. The POD resources are not changed.
+type DryRunPodAnnotation struct {
+	// BoostName is the name of the StartupCPUBoost that evaluated the POD
+	BoostName string `json:"boostName,omitempty"`
+	// BoostGeneration is the generation of the StartupCPUBoost that evaluated the POD
+	BoostGeneration int64     `json:"boostGeneration,omitempty"`
+	Timestamp       time.Time `json:"timestamp,omitempty"`
+	// QOSClass is the QoS class of the POD, that is kept by the boosted containers
+	QOSClass corev1.PodQOSClass `json:"qosClass,omitempty"`
+	// ResourcePolicies hold the name of the resource policy that would be applied
+	// on the containers
+	ResourcePolicies map[string]string `json:"resourcePolicies,omitempty"`
+	InitCPURequests  map[string]string `json:"initCPURequests,omitempty"`
+	InitCPULimits    map[string]string `json:"initCPULimits,omitempty"`
+	// BoostedCPURequests and BoostedCPULimits hold the container CPU resources
+	// that would be set by the boost
+	BoostedCPURequests map[string]string `json:"boostedCPURequests,omitempty"`
+	BoostedCPULimits   map[string]string `json:"boostedCPULimits,omitempty"`
+	// SkippedContainers hold the reasons of not boosting the containers
+	SkippedContainers map[string]string `json:"skippedContainers,omitempty"`
+}
+
+// NewDryRunAnnotation returns the dry-run annotation describing the boost recorded
+// in a given boost annotation that would keep a given POD QoS class
+func NewDryRunAnnotation(boost *BoostPodAnnotation, qosClass corev1.PodQOSClass) *DryRunPodAnnotation {
+	return &DryRunPodAnnotation{
+		BoostName:          boost.BoostName,
+		BoostGeneration:    boost.BoostGeneration,
+		Timestamp:          boost.BoostTimestamp,
+		QOSClass:           qosClass,
+		ResourcePolicies:   boost.ResourcePolicies,
+		InitCPURequests:    boost.InitCPURequests,
+		InitCPULimits:      boost.InitCPULimits,
+		BoostedCPURequests: boost.BoostedCPURequests,
+		BoostedCPULimits:   boost.BoostedCPULimits,
+		SkippedContainers:  boost.SkippedContainers,
+	}
+}
+
+func (a *DryRunPodAnnotation) ToJSON() string {
+	result, err := json.Marshal(a)
+	if err != nil {
+		panic("failed to marshall to JSON: " + err.Error())
+	}
+	return string(result)
+}
+
+func (a *DryRunPodAnnotation) Apply(pod *corev1.Pod) {
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+	pod.Annotations[DryRunAnnotationKey] = a.ToJSON()
+}
+
+// DryRunAnnotationFromPod returns the dry-run annotation of a given POD
+func DryRunAnnotationFromPod(pod *corev1.Pod) (*DryRunPodAnnotation, error) {
+	annotation := &DryRunPodAnnotation{}
+	data, ok := pod.Annotations[DryRunAnnotationKey]
+	if !ok {
+		return nil, errors.New("dry-run annotation not found")
+	}
+	if err := json.Unmarshal([]byte(data), annotation); err != nil {
+		return nil, err
+	}
+	return annotation, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	corev1 "k8s.io/api/core/v1"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("DryRunPodAnnotation", func() {
+	var (
+		boostAnnot *pod.BoostPodAnnotation
+		dryRun     *pod.DryRunPodAnnotation
+		p          *corev1.Pod
+	)
+	BeforeEach(func() {
+		boostAnnot = pod.NewBoostAnnotation()
+		boostAnnot.BoostName = "boost-001"
+		boostAnnot.BoostGeneration = 3
+		boostAnnot.UpdateInitResources("container-one", corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: apiResource.MustParse("500m")},
+		})
+		boostAnnot.UpdateBoostedResources("container-one", corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: apiResource.MustParse("1")},
+		})
+		boostAnnot.UpdateResourcePolicy("container-one", "PercentageIncrease")
+		boostAnnot.UpdateSkippedContainer("container-two", "SkippedQoSChange")
+		p = &corev1.Pod{}
+	})
+	JustBeforeEach(func() {
+		dryRun = pod.NewDryRunAnnotation(boostAnnot, corev1.PodQOSBurstable)
+	})
+	It("describes the boost of the boost annotation", func() {
+		Expect(dryRun.BoostName).To(Equal("boost-001"))
+		Expect(dryRun.BoostGeneration).To(Equal(int64(3)))
+		Expect(dryRun.Timestamp).To(Equal(boostAnnot.BoostTimestamp))
+		Expect(dryRun.QOSClass).To(Equal(corev1.PodQOSBurstable))
+		Expect(dryRun.InitCPURequests).To(HaveKeyWithValue("container-one", "500m"))
+		Expect(dryRun.BoostedCPURequests).To(HaveKeyWithValue("container-one", "1"))
+		Expect(dryRun.ResourcePolicies).To(HaveKeyWithValue("container-one", "PercentageIncrease"))
+		Expect(dryRun.SkippedContainers).To(HaveKeyWithValue("container-two", "SkippedQoSChange"))
+	})
+	When("annotation is applied on the POD", func() {
+		JustBeforeEach(func() {
+			dryRun.Apply(p)
+		})
+		It("does not add the boost annotation", func() {
+			Expect(p.Annotations).NotTo(HaveKey(pod.BoostAnnotationKey))
+		})
+		It("can be read from the POD", func() {
+			fromPod, err := pod.DryRunAnnotationFromPod(p)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fromPod.BoostName).To(Equal(dryRun.BoostName))
+			Expect(fromPod.QOSClass).To(Equal(dryRun.QOSClass))
+			Expect(fromPod.BoostedCPURequests).To(Equal(dryRun.BoostedCPURequests))
+		})
+	})
+	When("POD has no dry-run annotation", func() {
+		It("errors", func() {
+			_, err := pod.DryRunAnnotationFromPod(p)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -27,6 +27,7 @@ const (
 	PodEventTypePodCreated       PodEventType = "PodCreated"
 	PodEventTypePodDeleted       PodEventType = "PodDeleted"
 	PodEventTypeConditionChanged PodEventType = "ConditionChanged"
+	PodEventTypeResourcesChanged PodEventType = "ResourcesChanged"
 )
 
 var (
@@ -0,0 +1,101 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// DisabledAnnotationKey is the key of the POD or namespace annotation that
+	// disables the boost when set to true
+	DisabledAnnotationKey = "autoscaling.x-k8s.io/startup-cpu-boost-disabled"
+	// PercentageAnnotationKey is the key of the POD or namespace annotation that
+	// overrides the value of the percentage increase resource policy
+	PercentageAnnotationKey = "autoscaling.x-k8s.io/startup-cpu-boost-percentage"
+	// DurationAnnotationKey is the key of the POD or namespace annotation that
+	// overrides the value of the fixed duration policy, i.e. 90s or 2m
+	DurationAnnotationKey = "autoscaling.x-k8s.io/startup-cpu-boost-duration"
+	// ProfileAnnotationKey is the key of the POD or namespace annotation that
+	// references the boost profile, i.e. jvm or ClusterStartupCPUBoostProfile/jvm
+	ProfileAnnotationKey = "autoscaling.x-k8s.io/startup-cpu-boost-profile"
+)
+
+// BoostOverrides holds the boost settings overridden with the POD and
+// namespace annotations
+type BoostOverrides struct {
+	// Disabled is true when the boost is disabled for the POD
+	Disabled bool
+	// Percentage is the overridden value of the percentage increase resource policy
+	Percentage *int64
+	// Duration is the overridden value of the fixed duration policy
+	Duration *time.Duration
+	// Profile is the reference to the boost profile in the annotation format
+	Profile string
+	// Warnings hold the messages on the malformed annotation values that were ignored
+	Warnings []string
+}
+
+// OverridesFromAnnotations returns the boost overrides defined with a given namespace
+// and POD annotations. The POD annotations take precedence over the namespace ones.
+// Malformed values are ignored and reported in the warnings.
+func OverridesFromAnnotations(namespace, pod map[string]string) BoostOverrides {
+	var result BoostOverrides
+	if value, source, ok := overrideValue(DisabledAnnotationKey, namespace, pod); ok {
+		disabled, err := strconv.ParseBool(value)
+		if err != nil {
+			result.Warnings = append(result.Warnings, malformedOverride(source, DisabledAnnotationKey, value))
+		}
+		result.Disabled = disabled
+	}
+	if value, source, ok := overrideValue(PercentageAnnotationKey, namespace, pod); ok {
+		percentage, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			result.Warnings = append(result.Warnings, malformedOverride(source, PercentageAnnotationKey, value))
+		} else {
+			result.Percentage = &percentage
+		}
+	}
+	if value, source, ok := overrideValue(DurationAnnotationKey, namespace, pod); ok {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			result.Warnings = append(result.Warnings, malformedOverride(source, DurationAnnotationKey, value))
+		} else {
+			result.Duration = &duration
+		}
+	}
+	if value, _, ok := overrideValue(ProfileAnnotationKey, namespace, pod); ok {
+		result.Profile = value
+	}
+	return result
+}
+
+// overrideValue returns the value of the annotation with a given key and the kind
+// of the object it was found on
+func overrideValue(key string, namespace, pod map[string]string) (string, string, bool) {
+	if value, ok := pod[key]; ok {
+		return value, "POD", true
+	}
+	if value, ok := namespace[key]; ok {
+		return value, "namespace", true
+	}
+	return "", "", false
+}
+
+func malformedOverride(source, key, value string) string {
+	return fmt.Sprintf("%s annotation %s has malformed value %q and is ignored", source, key, value)
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod_test
+
+import (
+	"time"
+
+	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Overrides", func() {
+	Describe("Parses the overrides from the annotations", func() {
+		var (
+			namespaceAnnotations map[string]string
+			podAnnotations       map[string]string
+			overrides            bpod.BoostOverrides
+		)
+		BeforeEach(func() {
+			namespaceAnnotations = nil
+			podAnnotations = nil
+		})
+		JustBeforeEach(func() {
+			overrides = bpod.OverridesFromAnnotations(namespaceAnnotations, podAnnotations)
+		})
+		When("there are no annotations", func() {
+			It("returns no overrides", func() {
+				Expect(overrides).To(Equal(bpod.BoostOverrides{}))
+			})
+		})
+		When("the POD has the annotations", func() {
+			BeforeEach(func() {
+				podAnnotations = map[string]string{
+					bpod.DisabledAnnotationKey:   "true",
+					bpod.PercentageAnnotationKey: "150",
+					bpod.DurationAnnotationKey:   "2m",
+					bpod.ProfileAnnotationKey:    "jvm",
+				}
+			})
+			It("returns the overrides", func() {
+				Expect(overrides.Disabled).To(BeTrue())
+				Expect(overrides.Percentage).To(HaveValue(Equal(int64(150))))
+				Expect(overrides.Duration).To(HaveValue(Equal(2 * time.Minute)))
+				Expect(overrides.Profile).To(Equal("jvm"))
+				Expect(overrides.Warnings).To(BeEmpty())
+			})
+		})
+		When("the POD and namespace have the annotations", func() {
+			BeforeEach(func() {
+				namespaceAnnotations = map[string]string{
+					bpod.DisabledAnnotationKey:   "true",
+					bpod.PercentageAnnotationKey: "50",
+					bpod.ProfileAnnotationKey:    "ClusterStartupCPUBoostProfile/jvm",
+				}
+				podAnnotations = map[string]string{
+					bpod.DisabledAnnotationKey: "false",
+					bpod.ProfileAnnotationKey:  "go",
+				}
+			})
+			It("returns the POD overrides taking precedence", func() {
+				Expect(overrides.Disabled).To(BeFalse())
+				Expect(overrides.Percentage).To(HaveValue(Equal(int64(50))))
+				Expect(overrides.Duration).To(BeNil())
+				Expect(overrides.Profile).To(Equal("go"))
+			})
+		})
+		When("the annotations have malformed values", func() {
+			BeforeEach(func() {
+				namespaceAnnotations = map[string]string{
+					bpod.DurationAnnotationKey: "ten minutes",
+				}
+				podAnnotations = map[string]string{
+					bpod.DisabledAnnotationKey:   "yes",
+					bpod.PercentageAnnotationKey: "50%",
+				}
+			})
+			It("ignores the values", func() {
+				Expect(overrides.Disabled).To(BeFalse())
+				Expect(overrides.Percentage).To(BeNil())
+				Expect(overrides.Duration).To(BeNil())
+			})
+			It("returns the warnings", func() {
+				Expect(overrides.Warnings).To(ConsistOf(
+					`POD annotation `+bpod.DisabledAnnotationKey+` has malformed value "yes" and is ignored`,
+					`POD annotation `+bpod.PercentageAnnotationKey+` has malformed value "50%" and is ignored`,
+					`namespace annotation `+bpod.DurationAnnotationKey+` has malformed value "ten minutes" and is ignored`,
+				))
+			})
+		})
+	})
+})
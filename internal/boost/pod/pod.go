@@ -22,10 +22,10 @@ import (
 	"fmt"
 	"time"
 
-	jsonpatch "github.com/evanphx/json-patch/v5"
 	corev1 "k8s.io/api/core/v1"
 	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -35,7 +35,20 @@ const (
 	BoostStateActive     = "Active"
 	BoostStateReverted   = "Reverted"
 	BoostStateInfeasible = "Infeasible"
-	EmptyPatchString     = "{}"
+	BoostStateDeferred   = "Deferred"
+	// BoostAnnotationVersion is the current version of the boost annotation schema
+	BoostAnnotationVersion = "v1"
+	// maxBoostStateTransitions is the number of most recent state transitions
+	// kept in the boost annotation
+	maxBoostStateTransitions = 10
+)
+
+const (
+	BoostReasonBoosted          = "Boosted"
+	BoostReasonResizeDeferred   = "ResizeDeferred"
+	BoostReasonResizeInfeasible = "ResizeInfeasible"
+	BoostReasonResizeResumed    = "ResizeResumed"
+	BoostReasonReverted         = "Reverted"
 )
 
 type BoostPodLabel struct {
@@ -49,21 +62,52 @@ func (l *BoostPodLabel) Apply(pod *corev1.Pod) {
 	pod.Labels[BoostLabelKey] = l.BoostName
 }
 
+// BoostPodAnnotation is the boost annotation of a POD. Annotations without
+// version were created by former releases and are parsed as the current version.
 type BoostPodAnnotation struct {
-	State           string            `json:"state,omitempty"`
-	BoostTimestamp  time.Time         `json:"timestamp,omitempty"`
-	InitCPURequests map[string]string `json:"initCPURequests,omitempty"`
-	InitCPULimits   map[string]string `json:"initCPULimits,omitempty"`
+	// Version is the version of the annotation schema
+	Version string `json:"version,omitempty"`
+	// BoostName is the name of the StartupCPUBoost that boosted the POD
+	BoostName string `json:"boostName,omitempty"`
+	// BoostGeneration is the generation of the StartupCPUBoost that boosted the POD
+	BoostGeneration int64     `json:"boostGeneration,omitempty"`
+	State           string    `json:"state,omitempty"`
+	BoostTimestamp  time.Time `json:"timestamp,omitempty"`
+	// FixedDuration holds the value of the fixed duration policy overridden
+	// for the POD with the annotation
+	FixedDuration string `json:"fixedDuration,omitempty"`
+	// ResourcePolicies hold the name of the resource policy applied on the containers
+	ResourcePolicies map[string]string `json:"resourcePolicies,omitempty"`
+	InitCPURequests  map[string]string `json:"initCPURequests,omitempty"`
+	InitCPULimits    map[string]string `json:"initCPULimits,omitempty"`
+	// BoostedCPURequests and BoostedCPULimits hold the container CPU resources
+	// set by the boost, used to detect changes done by other actors before revert
+	BoostedCPURequests map[string]string `json:"boostedCPURequests,omitempty"`
+	BoostedCPULimits   map[string]string `json:"boostedCPULimits,omitempty"`
+	// SkippedContainers hold the reasons of not boosting the containers
+	SkippedContainers map[string]string `json:"skippedContainers,omitempty"`
+	// Transitions hold the most recent boost state transitions
+	Transitions []BoostStateTransition `json:"transitions,omitempty"`
 }
 
-type mutatePodFunc func(pod *corev1.Pod) error
+// BoostStateTransition describes the transition of a boost state
+type BoostStateTransition struct {
+	State     string    `json:"state"`
+	Timestamp time.Time `json:"timestamp"`
+	Reason    string    `json:"reason,omitempty"`
+	Message   string    `json:"message,omitempty"`
+}
 
 func NewBoostAnnotation() *BoostPodAnnotation {
 	return &BoostPodAnnotation{
-		State:           BoostStateActive,
-		BoostTimestamp:  time.Now(),
-		InitCPURequests: make(map[string]string),
-		InitCPULimits:   make(map[string]string),
+		Version:            BoostAnnotationVersion,
+		State:              BoostStateActive,
+		BoostTimestamp:     time.Now(),
+		ResourcePolicies:   make(map[string]string),
+		InitCPURequests:    make(map[string]string),
+		InitCPULimits:      make(map[string]string),
+		BoostedCPURequests: make(map[string]string),
+		BoostedCPULimits:   make(map[string]string),
 	}
 }
 
@@ -80,6 +124,28 @@ func (a *BoostPodAnnotation) HasInitCPUResources() bool {
 	return len(a.InitCPURequests) > 0 || len(a.InitCPULimits) > 0
 }
 
+// FixedDurationOverride returns the value of the fixed duration policy overridden
+// for the POD, if any.
+func (a *BoostPodAnnotation) FixedDurationOverride() (time.Duration, bool) {
+	if a.FixedDuration == "" {
+		return 0, false
+	}
+	d, err := time.ParseDuration(a.FixedDuration)
+	if err != nil {
+		return 0, false
+	}
+	return d, true
+}
+
+// HasContainer returns true if the annotation records the boost or the skip of
+// a container with a given name.
+func (a *BoostPodAnnotation) HasContainer(containerName string) bool {
+	_, hasRequests := a.InitCPURequests[containerName]
+	_, hasLimits := a.InitCPULimits[containerName]
+	_, skipped := a.SkippedContainers[containerName]
+	return hasRequests || hasLimits || skipped
+}
+
 func (a *BoostPodAnnotation) UpdateInitResources(
 	containerName string, resources corev1.ResourceRequirements) {
 	if cpuRequests, ok := resources.Requests[corev1.ResourceCPU]; ok {
@@ -90,6 +156,65 @@ func (a *BoostPodAnnotation) UpdateInitResources(
 	}
 }
 
+// SetState sets the boost state and records the state transition with a given
+// reason and message
+func (a *BoostPodAnnotation) SetState(state, reason, message string) {
+	a.State = state
+	a.Transitions = append(a.Transitions, BoostStateTransition{
+		State:     state,
+		Timestamp: time.Now(),
+		Reason:    reason,
+		Message:   message,
+	})
+	if len(a.Transitions) > maxBoostStateTransitions {
+		a.Transitions = a.Transitions[len(a.Transitions)-maxBoostStateTransitions:]
+	}
+}
+
+// HasTransition returns true if the annotation records the transition to
+// a given state
+func (a *BoostPodAnnotation) HasTransition(state string) bool {
+	for _, transition := range a.Transitions {
+		if transition.State == state {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateResourcePolicy records the name of the resource policy applied on a container
+func (a *BoostPodAnnotation) UpdateResourcePolicy(containerName, policyName string) {
+	if a.ResourcePolicies == nil {
+		a.ResourcePolicies = make(map[string]string)
+	}
+	a.ResourcePolicies[containerName] = policyName
+}
+
+// UpdateSkippedContainer records the reason of not boosting a container
+func (a *BoostPodAnnotation) UpdateSkippedContainer(containerName, reason string) {
+	if a.SkippedContainers == nil {
+		a.SkippedContainers = make(map[string]string)
+	}
+	a.SkippedContainers[containerName] = reason
+}
+
+// UpdateBoostedResources records the container CPU resources set by the boost.
+func (a *BoostPodAnnotation) UpdateBoostedResources(
+	containerName string, resources corev1.ResourceRequirements) {
+	if a.BoostedCPURequests == nil {
+		a.BoostedCPURequests = make(map[string]string)
+	}
+	if a.BoostedCPULimits == nil {
+		a.BoostedCPULimits = make(map[string]string)
+	}
+	if cpuRequests, ok := resources.Requests[corev1.ResourceCPU]; ok {
+		a.BoostedCPURequests[containerName] = cpuRequests.String()
+	}
+	if cpuLimits, ok := resources.Limits[corev1.ResourceCPU]; ok {
+		a.BoostedCPULimits[containerName] = cpuLimits.String()
+	}
+}
+
 func (a *BoostPodAnnotation) Apply(pod *corev1.Pod) {
 	if pod.Annotations == nil {
 		pod.Annotations = make(map[string]string)
@@ -106,9 +231,28 @@ func BoostAnnotationFromPod(pod *corev1.Pod) (*BoostPodAnnotation, error) {
 	if err := json.Unmarshal([]byte(data), annotation); err != nil {
 		return nil, err
 	}
+	switch annotation.Version {
+	case "":
+		annotation.upgradeUnversioned()
+	case BoostAnnotationVersion:
+	default:
+		return nil, fmt.Errorf("unsupported boost annotation version %q", annotation.Version)
+	}
 	return annotation, nil
 }
 
+// upgradeUnversioned upgrades the annotation created by former releases to
+// the current version of the schema
+func (a *BoostPodAnnotation) upgradeUnversioned() {
+	a.Version = BoostAnnotationVersion
+	if len(a.Transitions) == 0 && a.State != "" {
+		a.Transitions = []BoostStateTransition{{
+			State:     a.State,
+			Timestamp: a.BoostTimestamp,
+		}}
+	}
+}
+
 func RevertResourceBoost(pod *corev1.Pod) error {
 	if err := revertBoostResources(pod); err != nil {
 		return err
@@ -136,7 +280,7 @@ func revertBoostLabelsWithBoostOnRestart(pod *corev1.Pod) error {
 	if err != nil {
 		return err
 	}
-	boostAnnotation.State = BoostStateReverted
+	boostAnnotation.SetState(BoostStateReverted, BoostReasonReverted, "")
 	pod.Annotations[BoostAnnotationKey] = boostAnnotation.ToJSON()
 	return nil
 }
@@ -172,21 +316,39 @@ func revertBoostResources(pod *corev1.Pod) error {
 	return nil
 }
 
-func buildPodPatch(pod *corev1.Pod, mutatePodFunc mutatePodFunc) ([]byte, error) {
-	podJSON, err := json.Marshal(pod)
-	if err != nil {
-		return nil, err
+// newMetadataPatchData returns the merge patch data setting given POD labels and
+// annotations, the nil values remove the keys. The patch holds the changed keys only,
+// so the labels and annotations set by other actors are not overwritten. When the
+// resource version is set, the patch fails with conflict if the POD was changed since.
+func newMetadataPatchData(labels, annotations map[string]*string, resourceVersion string) ([]byte, error) {
+	metadata := make(map[string]any)
+	if len(labels) > 0 {
+		metadata["labels"] = labels
 	}
-	if err := mutatePodFunc(pod); err != nil {
-		return nil, err
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
 	}
-	updatedPodJSON, err := json.Marshal(pod)
+	if resourceVersion != "" {
+		metadata["resourceVersion"] = resourceVersion
+	}
+	return json.Marshal(map[string]any{"metadata": metadata})
+}
+
+// newBoostStatePatchData returns the merge patch data setting the state in the POD's
+// boost annotation. The annotation is read from a given POD, so the patch is bound
+// to its resource version.
+func newBoostStatePatchData(pod *corev1.Pod, state, reason, message string) ([]byte, error) {
+	annotation, err := BoostAnnotationFromPod(pod)
 	if err != nil {
 		return nil, err
 	}
-	return jsonpatch.CreateMergePatch(podJSON, updatedPodJSON)
+	annotation.SetState(state, reason, message)
+	value := annotation.ToJSON()
+	return newMetadataPatchData(nil, map[string]*string{BoostAnnotationKey: &value}, pod.ResourceVersion)
 }
 
+// NewRevertBoostLabelsPatch returns a patch that removes the boost label and annotation
+// from the POD
 func NewRevertBoostLabelsPatch() client.Patch {
 	return &revertBoostLabelsPatch{}
 }
@@ -199,13 +361,35 @@ func (p *revertBoostLabelsPatch) Type() types.PatchType {
 }
 
 func (p *revertBoostLabelsPatch) Data(obj client.Object) ([]byte, error) {
-	pod, ok := obj.(*corev1.Pod)
-	if !ok {
+	if _, ok := obj.(*corev1.Pod); !ok {
 		return nil, errors.New("revertBoostLabelsPatch applies only on *corev1.Pod objects")
 	}
-	return buildPodPatch(pod, revertBoostLabels)
+	return newMetadataPatchData(map[string]*string{BoostLabelKey: nil},
+		map[string]*string{BoostAnnotationKey: nil}, "")
+}
+
+// NewRemoveBoostLabelPatch returns a patch that removes the boost label from the POD,
+// keeping the annotations
+func NewRemoveBoostLabelPatch() client.Patch {
+	return &removeBoostLabelPatch{}
+}
+
+type removeBoostLabelPatch struct {
+}
+
+func (p *removeBoostLabelPatch) Type() types.PatchType {
+	return types.MergePatchType
+}
+
+func (p *removeBoostLabelPatch) Data(obj client.Object) ([]byte, error) {
+	if _, ok := obj.(*corev1.Pod); !ok {
+		return nil, errors.New("removeBoostLabelPatch applies only on *corev1.Pod objects")
+	}
+	return newMetadataPatchData(map[string]*string{BoostLabelKey: nil}, nil, "")
 }
 
+// NewRevertBoostLabelsWithBoostOnRestartPatch returns a patch that sets the reverted
+// state in the POD's boost annotation, keeping the boost label
 func NewRevertBoostLabelsWithBoostOnRestartPatch() client.Patch {
 	return &revertBoostLabelsWithBoostOnRestartPatch{}
 }
@@ -222,30 +406,72 @@ func (p *revertBoostLabelsWithBoostOnRestartPatch) Data(obj client.Object) ([]by
 	if !ok {
 		return nil, errors.New("revertBoostLabelsPatch applies only on *corev1.Pod objects")
 	}
-	return buildPodPatch(pod, revertBoostLabelsWithBoostOnRestart)
+	return newBoostStatePatchData(pod, BoostStateReverted, BoostReasonReverted, "")
 }
 
-func NewRevertBootsResourcesPatch() client.Patch {
-	return &revertBoostResourcesPatch{}
+// NewBoostStatePatch returns a patch that sets the state in the POD's boost annotation
+// and records the state transition with a given reason and message
+func NewBoostStatePatch(state, reason, message string) client.Patch {
+	return &boostStatePatch{state: state, reason: reason, message: message}
 }
 
-type revertBoostResourcesPatch struct {
+type boostStatePatch struct {
+	state   string
+	reason  string
+	message string
 }
 
-func (p *revertBoostResourcesPatch) Type() types.PatchType {
+func (p *boostStatePatch) Type() types.PatchType {
 	return types.MergePatchType
 }
 
-func (p *revertBoostResourcesPatch) Data(obj client.Object) ([]byte, error) {
+func (p *boostStatePatch) Data(obj client.Object) ([]byte, error) {
 	pod, ok := obj.(*corev1.Pod)
 	if !ok {
-		return nil, errors.New("revertBoostResourcesPatch applies only on *corev1.Pod objects")
+		return nil, errors.New("boostStatePatch applies only on *corev1.Pod objects")
 	}
-	patchData, err := buildPodPatch(pod, revertBoostResources)
+	return newBoostStatePatchData(pod, p.state, p.reason, p.message)
+}
+
+// RevertBoostResourcesApplyConfiguration returns a server-side apply configuration that
+// sets the boosted container CPU resources to their original values. When delta is set,
+// only the boost increase is reverted, preserving the changes done by other actors.
+// The configuration is bound to the POD's resource version, so it is not applied over
+// the changes that were not observed.
+func RevertBoostResourcesApplyConfiguration(pod *corev1.Pod, delta bool) (*corev1ac.PodApplyConfiguration, error) {
+	annotation, err := BoostAnnotationFromPod(pod)
 	if err != nil {
-		return []byte(EmptyPatchString), nil
+		return nil, fmt.Errorf("failed to get boost annotation from pod: %s", err)
+	}
+	revertFunc := revertBoostResources
+	if delta {
+		revertFunc = revertBoostResourcesDelta
+	}
+	revertedPod := pod.DeepCopy()
+	if err := revertFunc(revertedPod); err != nil {
+		return nil, err
+	}
+	spec := corev1ac.PodSpec()
+	for _, container := range revertedPod.Spec.Containers {
+		_, hasRequests := annotation.InitCPURequests[container.Name]
+		_, hasLimits := annotation.InitCPULimits[container.Name]
+		if !hasRequests && !hasLimits {
+			continue
+		}
+		resources := corev1ac.ResourceRequirements()
+		if request, ok := container.Resources.Requests[corev1.ResourceCPU]; ok {
+			resources.WithRequests(corev1.ResourceList{corev1.ResourceCPU: request})
+		}
+		if limit, ok := container.Resources.Limits[corev1.ResourceCPU]; ok {
+			resources.WithLimits(corev1.ResourceList{corev1.ResourceCPU: limit})
+		}
+		spec.WithContainers(corev1ac.Container().
+			WithName(container.Name).
+			WithResources(resources))
 	}
-	return patchData, nil
+	return corev1ac.Pod(pod.Name, pod.Namespace).
+		WithResourceVersion(pod.ResourceVersion).
+		WithSpec(spec), nil
 }
 
 // ResourceResizeRequiresRestart determines if a in-place resize of a resource with a given
@@ -15,6 +15,7 @@
 package pod_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
 var _ = Describe("Pod", func() {
@@ -49,6 +51,62 @@ var _ = Describe("Pod", func() {
 			bpod.BoostAnnotationKey: annot.ToJSON(),
 		}
 	})
+	Describe("Parses the boost annotation", func() {
+		When("annotation has no version", func() {
+			It("upgrades the annotation to the current version", func() {
+				boostAnnot, err := bpod.BoostAnnotationFromPod(pod)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boostAnnot.Version).To(Equal(bpod.BoostAnnotationVersion))
+				Expect(boostAnnot.State).To(Equal(annot.State))
+				Expect(boostAnnot.InitCPURequests).To(Equal(annot.InitCPURequests))
+				Expect(boostAnnot.Transitions).To(HaveLen(1))
+				Expect(boostAnnot.Transitions[0].State).To(Equal(annot.State))
+				Expect(boostAnnot.Transitions[0].Timestamp).To(BeTemporally("==", annot.BoostTimestamp))
+			})
+		})
+		When("annotation has current version", func() {
+			BeforeEach(func() {
+				annot.Version = bpod.BoostAnnotationVersion
+				annot.BoostName = "boost-001"
+				annot.BoostGeneration = 3
+				annot.ResourcePolicies = map[string]string{containerOneName: "PercentageIncrease"}
+				annot.SetState(bpod.BoostStateInfeasible, bpod.BoostReasonResizeInfeasible, "Node didn't have enough capacity")
+				annot.Apply(pod)
+			})
+			It("returns the annotation", func() {
+				boostAnnot, err := bpod.BoostAnnotationFromPod(pod)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boostAnnot.BoostName).To(Equal(annot.BoostName))
+				Expect(boostAnnot.BoostGeneration).To(Equal(annot.BoostGeneration))
+				Expect(boostAnnot.ResourcePolicies).To(Equal(annot.ResourcePolicies))
+				Expect(boostAnnot.State).To(Equal(bpod.BoostStateInfeasible))
+				Expect(boostAnnot.Transitions).To(HaveLen(1))
+				Expect(boostAnnot.Transitions[0].Reason).To(Equal(bpod.BoostReasonResizeInfeasible))
+				Expect(boostAnnot.Transitions[0].Message).To(Equal("Node didn't have enough capacity"))
+			})
+		})
+		When("annotation has unsupported version", func() {
+			BeforeEach(func() {
+				annot.Version = "v99"
+				annot.Apply(pod)
+			})
+			It("returns an error", func() {
+				_, err := bpod.BoostAnnotationFromPod(pod)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+	Describe("Sets the boost annotation state", func() {
+		It("keeps the most recent state transitions", func() {
+			for range 15 {
+				annot.SetState(bpod.BoostStateDeferred, bpod.BoostReasonResizeDeferred, "")
+			}
+			annot.SetState(bpod.BoostStateActive, bpod.BoostReasonResizeResumed, "")
+			Expect(annot.State).To(Equal(bpod.BoostStateActive))
+			Expect(annot.Transitions).To(HaveLen(10))
+			Expect(annot.Transitions[9].Reason).To(Equal(bpod.BoostReasonResizeResumed))
+		})
+	})
 	Describe("Reverts the POD container resources", func() {
 		Context("boost on restart feature is disabled", func() {
 			JustBeforeEach(func() {
@@ -113,6 +171,15 @@ var _ = Describe("Pod", func() {
 			})
 		})
 	})
+	Describe("Creates remove boost label patch", func() {
+		It("returns patch removing the boost label only", func() {
+			pod.Labels["app"] = "demo"
+			patchData, err := bpod.NewRemoveBoostLabelPatch().Data(pod)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(patchData)).To(Equal(fmt.Sprintf(
+				"{\"metadata\":{\"labels\":{\"%s\":null}}}", bpod.BoostLabelKey)))
+		})
+	})
 	Describe("Creates revert boost labels patch", func() {
 		Context("boost on restart feature is disabled", func() {
 			var (
@@ -123,20 +190,16 @@ var _ = Describe("Pod", func() {
 				patch := bpod.NewRevertBoostLabelsPatch()
 				patchData, err = patch.Data(pod)
 			})
-			When("Pod is missing boost labels and annotations", func() {
+			When("Pod has boost labels and annotations", func() {
 				BeforeEach(func() {
-					delete(pod.Annotations, bpod.BoostAnnotationKey)
-					delete(pod.Labels, bpod.BoostLabelKey)
+					pod.Labels["app"] = "demo"
+					pod.ResourceVersion = "10"
 				})
-				It("returns valid patch", func() {
-					Expect(err).NotTo(HaveOccurred())
-					Expect(string(patchData)).To(Equal("{}"))
-				})
-			})
-			When("Pod has boost labels and annotations", func() {
-				It("returns valid patch", func() {
+				It("returns patch removing the boost label and annotation only", func() {
 					Expect(err).NotTo(HaveOccurred())
-					Expect(string(patchData)).To(Equal("{\"metadata\":{\"annotations\":null,\"labels\":null}}"))
+					Expect(string(patchData)).To(Equal(fmt.Sprintf(
+						"{\"metadata\":{\"annotations\":{\"%s\":null},\"labels\":{\"%s\":null}}}",
+						bpod.BoostAnnotationKey, bpod.BoostLabelKey)))
 				})
 			})
 		})
@@ -159,51 +222,85 @@ var _ = Describe("Pod", func() {
 				})
 			})
 			When("Pod has boost labels and annotations", func() {
+				BeforeEach(func() {
+					pod.ResourceVersion = "10"
+				})
 				It("returns valid patch", func() {
 					Expect(err).NotTo(HaveOccurred())
-					expectedAnnot := bpod.BoostPodAnnotation{
-						State:           bpod.BoostStateReverted,
-						BoostTimestamp:  annot.BoostTimestamp,
-						InitCPURequests: annot.InitCPURequests,
-						InitCPULimits:   annot.InitCPULimits,
-					}
-					expectedPatch := fmt.Sprintf(
-						"{\"metadata\":{\"annotations\":{\"%s\":%q}}}",
-						bpod.BoostAnnotationKey, expectedAnnot.ToJSON())
-					Expect(string(patchData)).To(Equal(expectedPatch))
+					patchedPod := &corev1.Pod{}
+					Expect(json.Unmarshal(patchData, patchedPod)).To(Succeed())
+					Expect(patchedPod.Labels).To(BeEmpty())
+					Expect(patchedPod.ResourceVersion).To(Equal(pod.ResourceVersion))
+					patchedAnnot, err := bpod.BoostAnnotationFromPod(patchedPod)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(patchedAnnot.Version).To(Equal(bpod.BoostAnnotationVersion))
+					Expect(patchedAnnot.State).To(Equal(bpod.BoostStateReverted))
+					Expect(patchedAnnot.BoostTimestamp).To(BeTemporally("==", annot.BoostTimestamp))
+					Expect(patchedAnnot.InitCPURequests).To(Equal(annot.InitCPURequests))
+					Expect(patchedAnnot.InitCPULimits).To(Equal(annot.InitCPULimits))
+					Expect(patchedAnnot.Transitions).To(HaveLen(2))
+					Expect(patchedAnnot.Transitions[1].State).To(Equal(bpod.BoostStateReverted))
+					Expect(patchedAnnot.Transitions[1].Reason).To(Equal(bpod.BoostReasonReverted))
 				})
 			})
 		})
 	})
-	Describe("Creates revert boost resources patch", func() {
+	Describe("Creates boost state patch", func() {
 		var (
 			patchData []byte
 			err       error
 		)
+		BeforeEach(func() {
+			pod.ResourceVersion = "10"
+		})
 		JustBeforeEach(func() {
-			patch := bpod.NewRevertBootsResourcesPatch()
+			patch := bpod.NewBoostStatePatch(bpod.BoostStateDeferred, bpod.BoostReasonResizeDeferred, "msg")
 			patchData, err = patch.Data(pod)
 		})
+		It("returns patch of the boost annotation bound to the resource version", func() {
+			Expect(err).NotTo(HaveOccurred())
+			patchedPod := &corev1.Pod{}
+			Expect(json.Unmarshal(patchData, patchedPod)).To(Succeed())
+			Expect(patchedPod.ResourceVersion).To(Equal(pod.ResourceVersion))
+			Expect(patchedPod.Labels).To(BeEmpty())
+			Expect(patchedPod.Spec.Containers).To(BeEmpty())
+			patchedAnnot, err := bpod.BoostAnnotationFromPod(patchedPod)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(patchedAnnot.State).To(Equal(bpod.BoostStateDeferred))
+			Expect(patchedAnnot.InitCPURequests).To(Equal(annot.InitCPURequests))
+		})
+	})
+	Describe("Creates revert boost resources apply configuration", func() {
+		var (
+			applyConfig *corev1ac.PodApplyConfiguration
+			err         error
+		)
+		JustBeforeEach(func() {
+			applyConfig, err = bpod.RevertBoostResourcesApplyConfiguration(pod, false)
+		})
 		When("Pod is missing boost labels and annotations", func() {
 			BeforeEach(func() {
 				delete(pod.Annotations, bpod.BoostAnnotationKey)
 				delete(pod.Labels, bpod.BoostLabelKey)
 			})
-			It("returns valid patch", func() {
-				Expect(err).NotTo(HaveOccurred())
-				Expect(string(patchData)).To(Equal("{}"))
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
 			})
 		})
 		When("Pod has boost labels and annotations", func() {
-			It("returns valid patch", func() {
+			It("returns valid apply configuration", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*applyConfig.Name).To(Equal(pod.Name))
+				Expect(*applyConfig.ResourceVersion).To(Equal(pod.ResourceVersion))
+				applyConfigData, err := json.Marshal(applyConfig.Spec)
 				Expect(err).NotTo(HaveOccurred())
-				expectedPatch := fmt.Sprintf(
-					"{\"spec\":{\"containers\":[{\"name\":\"container-one\",\"resources\":{\"limits\":{\"cpu\":\"%s\"},"+
+				expectedApplyConfig := fmt.Sprintf(
+					"{\"containers\":[{\"name\":\"container-one\",\"resources\":{\"limits\":{\"cpu\":\"%s\"},"+
 						"\"requests\":{\"cpu\":\"%s\"}}},{\"name\":\"container-two\",\"resources\":{\"limits\":{\"cpu\":\"%s\"},"+
-						"\"requests\":{\"cpu\":\"%s\"}}}]}}",
+						"\"requests\":{\"cpu\":\"%s\"}}}]}",
 					annot.InitCPULimits[containerOneName], annot.InitCPURequests[containerOneName],
 					annot.InitCPULimits[containerTwoName], annot.InitCPURequests[containerTwoName])
-				Expect(string(patchData)).To(Equal(expectedPatch))
+				Expect(string(applyConfigData)).To(Equal(expectedApplyConfig))
 			})
 		})
 	})
@@ -216,6 +313,8 @@ func expectPodMetadataRevertedWithBoostOnRestart(pod *corev1.Pod, annot *bpod.Bo
 	boostAnnot, err := bpod.BoostAnnotationFromPod(pod)
 	Expect(err).NotTo(HaveOccurred())
 	Expect(boostAnnot.State).To(Equal(bpod.BoostStateReverted))
+	Expect(boostAnnot.Transitions).NotTo(BeEmpty())
+	Expect(boostAnnot.Transitions[len(boostAnnot.Transitions)-1].Reason).To(Equal(bpod.BoostReasonReverted))
 	Expect(boostAnnot.InitCPURequests).To(Equal(annot.InitCPURequests))
 	Expect(boostAnnot.InitCPULimits).To(Equal(annot.InitCPULimits))
 }
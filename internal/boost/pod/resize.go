@@ -0,0 +1,114 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ResizeStatus defines the status of POD's in-place resize as reported by the kubelet
+type ResizeStatus string
+
+const (
+	// ResizeStatusNone indicates that there is no pending nor in progress resize
+	ResizeStatusNone ResizeStatus = ""
+	// ResizeStatusInProgress indicates that the kubelet allocated the resources
+	// and the resize is being actuated
+	ResizeStatusInProgress ResizeStatus = "InProgress"
+	// ResizeStatusDeferred indicates that the resize is feasible but can't be
+	// granted by the kubelet at the moment
+	ResizeStatusDeferred ResizeStatus = "Deferred"
+	// ResizeStatusInfeasible indicates that the resize can't be granted by the
+	// kubelet on the node
+	ResizeStatusInfeasible ResizeStatus = "Infeasible"
+)
+
+// PodResizeStatus determines the in-place resize status of a given POD basing on
+// the PodResizePending and PodResizeInProgress conditions. The function returns
+// the status and the message reported by the kubelet.
+func PodResizeStatus(pod *corev1.Pod) (ResizeStatus, string) {
+	var inProgress bool
+	var inProgressMessage string
+	for _, condition := range pod.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case corev1.PodResizePending:
+			switch condition.Reason {
+			case corev1.PodReasonInfeasible:
+				return ResizeStatusInfeasible, condition.Message
+			case corev1.PodReasonDeferred:
+				return ResizeStatusDeferred, condition.Message
+			}
+		case corev1.PodResizeInProgress:
+			inProgress = true
+			inProgressMessage = condition.Message
+		}
+	}
+	if inProgress {
+		return ResizeStatusInProgress, inProgressMessage
+	}
+	return ResizeStatusNone, ""
+}
+
+// CPUResourcesActuated determines if CPU resources of all POD containers reported in
+// the container statuses are equal to the ones from the POD spec. Containers without
+// reported resources are considered as actuated.
+func CPUResourcesActuated(pod *corev1.Pod) bool {
+	if status, _ := PodResizeStatus(pod); status != ResizeStatusNone {
+		return false
+	}
+	statuses := make(map[string]*corev1.ResourceRequirements, len(pod.Status.ContainerStatuses))
+	for _, status := range pod.Status.ContainerStatuses {
+		statuses[status.Name] = status.Resources
+	}
+	for _, container := range pod.Spec.Containers {
+		actual, ok := statuses[container.Name]
+		if !ok || actual == nil {
+			continue
+		}
+		if actual.Requests.Cpu().Cmp(*container.Resources.Requests.Cpu()) != 0 {
+			return false
+		}
+		if actual.Limits.Cpu().Cmp(*container.Resources.Limits.Cpu()) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainerCPUResourcesChanged determines if the actual CPU resources reported in
+// the container statuses differ between given PODs.
+func ContainerCPUResourcesChanged(oldPod, newPod *corev1.Pod) bool {
+	oldResources := make(map[string]*corev1.ResourceRequirements, len(oldPod.Status.ContainerStatuses))
+	for _, status := range oldPod.Status.ContainerStatuses {
+		oldResources[status.Name] = status.Resources
+	}
+	for _, status := range newPod.Status.ContainerStatuses {
+		oldRes, ok := oldResources[status.Name]
+		if !ok || (oldRes == nil) != (status.Resources == nil) {
+			return true
+		}
+		if status.Resources == nil {
+			continue
+		}
+		if oldRes.Requests.Cpu().Cmp(*status.Resources.Requests.Cpu()) != 0 ||
+			oldRes.Limits.Cpu().Cmp(*status.Resources.Limits.Cpu()) != 0 {
+			return true
+		}
+	}
+	return false
+}
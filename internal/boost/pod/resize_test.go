@@ -0,0 +1,132 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod_test
+
+import (
+	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Resize", func() {
+	var pod *corev1.Pod
+	BeforeEach(func() {
+		pod = podTemplate.DeepCopy()
+	})
+	Describe("Determines POD resize status", func() {
+		DescribeTable("returns status basing on resize conditions",
+			func(conditions []corev1.PodCondition, expected bpod.ResizeStatus) {
+				pod.Status.Conditions = conditions
+				status, _ := bpod.PodResizeStatus(pod)
+				Expect(status).To(Equal(expected))
+			},
+			Entry("no conditions", nil, bpod.ResizeStatusNone),
+			Entry("resize deferred", []corev1.PodCondition{{
+				Type:   corev1.PodResizePending,
+				Status: corev1.ConditionTrue,
+				Reason: corev1.PodReasonDeferred,
+			}}, bpod.ResizeStatusDeferred),
+			Entry("resize infeasible", []corev1.PodCondition{{
+				Type:   corev1.PodResizePending,
+				Status: corev1.ConditionTrue,
+				Reason: corev1.PodReasonInfeasible,
+			}}, bpod.ResizeStatusInfeasible),
+			Entry("resize in progress", []corev1.PodCondition{{
+				Type:   corev1.PodResizeInProgress,
+				Status: corev1.ConditionTrue,
+			}}, bpod.ResizeStatusInProgress),
+			Entry("resize pending takes precedence over in progress", []corev1.PodCondition{
+				{
+					Type:   corev1.PodResizeInProgress,
+					Status: corev1.ConditionTrue,
+				},
+				{
+					Type:   corev1.PodResizePending,
+					Status: corev1.ConditionTrue,
+					Reason: corev1.PodReasonInfeasible,
+				},
+			}, bpod.ResizeStatusInfeasible),
+		)
+	})
+	Describe("Determines if CPU resources are actuated", func() {
+		When("container statuses have no resources", func() {
+			It("returns true", func() {
+				Expect(bpod.CPUResourcesActuated(pod)).To(BeTrue())
+			})
+		})
+		When("container status resources are equal to spec", func() {
+			BeforeEach(func() {
+				pod.Status.ContainerStatuses = containerStatusesFromSpec(pod)
+			})
+			It("returns true", func() {
+				Expect(bpod.CPUResourcesActuated(pod)).To(BeTrue())
+			})
+		})
+		When("container status resources differ from spec", func() {
+			BeforeEach(func() {
+				pod.Status.ContainerStatuses = containerStatusesFromSpec(pod)
+				pod.Status.ContainerStatuses[0].Resources.Requests[corev1.ResourceCPU] = apiResource.MustParse("3")
+			})
+			It("returns false", func() {
+				Expect(bpod.CPUResourcesActuated(pod)).To(BeFalse())
+			})
+		})
+		When("resize is in progress", func() {
+			BeforeEach(func() {
+				pod.Status.ContainerStatuses = containerStatusesFromSpec(pod)
+				pod.Status.Conditions = []corev1.PodCondition{{
+					Type:   corev1.PodResizeInProgress,
+					Status: corev1.ConditionTrue,
+				}}
+			})
+			It("returns false", func() {
+				Expect(bpod.CPUResourcesActuated(pod)).To(BeFalse())
+			})
+		})
+	})
+	Describe("Determines if container CPU resources changed", func() {
+		var newPod *corev1.Pod
+		BeforeEach(func() {
+			pod.Status.ContainerStatuses = containerStatusesFromSpec(pod)
+			newPod = pod.DeepCopy()
+		})
+		When("container status resources are the same", func() {
+			It("returns false", func() {
+				Expect(bpod.ContainerCPUResourcesChanged(pod, newPod)).To(BeFalse())
+			})
+		})
+		When("container status CPU limits changed", func() {
+			BeforeEach(func() {
+				newPod.Status.ContainerStatuses[1].Resources.Limits[corev1.ResourceCPU] = apiResource.MustParse("5")
+			})
+			It("returns true", func() {
+				Expect(bpod.ContainerCPUResourcesChanged(pod, newPod)).To(BeTrue())
+			})
+		})
+	})
+})
+
+func containerStatusesFromSpec(pod *corev1.Pod) []corev1.ContainerStatus {
+	statuses := make([]corev1.ContainerStatus, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		statuses = append(statuses, corev1.ContainerStatus{
+			Name:      container.Name,
+			Resources: container.Resources.DeepCopy(),
+		})
+	}
+	return statuses
+}
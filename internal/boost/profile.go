@@ -0,0 +1,191 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boost
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
+	"github.com/google/kube-startup-cpu-boost/internal/boost/duration"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups=autoscaling.x-k8s.io,resources=startupcpuboostprofiles;clusterstartupcpuboostprofiles,verbs=get;list;watch
+
+// ProfileResolutionTimeout bounds the resolution of the profile referenced by a boost
+const ProfileResolutionTimeout = 10 * time.Second
+
+// ProfileResolver resolves the StartupCPUBoost profiles referenced by the boosts
+// and the POD annotations
+type ProfileResolver interface {
+	// Profile returns the spec of the profile with a given reference. The namespaced
+	// profile is looked up in a given namespace.
+	Profile(ctx context.Context, namespace string, ref autoscaling.ProfileReference) (*autoscaling.StartupCPUBoostProfileSpec, error)
+}
+
+type profileResolverImpl struct {
+	reader client.Reader
+}
+
+// NewProfileResolver constructs a new ProfileResolver reading the profiles with
+// a given reader
+func NewProfileResolver(reader client.Reader) ProfileResolver {
+	return &profileResolverImpl{
+		reader: reader,
+	}
+}
+
+// Profile returns the spec of the profile with a given reference. The namespaced
+// profile is looked up in a given namespace.
+func (r *profileResolverImpl) Profile(ctx context.Context, namespace string,
+	ref autoscaling.ProfileReference) (*autoscaling.StartupCPUBoostProfileSpec, error) {
+	switch ref.Kind {
+	case autoscaling.ProfileKindCluster:
+		profile := &autoscaling.ClusterStartupCPUBoostProfile{}
+		if err := r.reader.Get(ctx, client.ObjectKey{Name: ref.Name}, profile); err != nil {
+			return nil, fmt.Errorf("failed to get %s %s: %w", ref.Kind, ref.Name, err)
+		}
+		return &profile.Spec, nil
+	case autoscaling.ProfileKindNamespaced, "":
+		profile := &autoscaling.StartupCPUBoostProfile{}
+		if err := r.reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, profile); err != nil {
+			return nil, fmt.Errorf("failed to get %s %s: %w", autoscaling.ProfileKindNamespaced, ref.Name, err)
+		}
+		return &profile.Spec, nil
+	default:
+		return nil, fmt.Errorf("unsupported profile kind %q", ref.Kind)
+	}
+}
+
+// MergeProfile returns a copy of a given boost spec with the resource and duration
+// policies of a given profile. The container policies of the boost precede the ones
+// of the profile, as the first matching policy applies, and the duration policies
+// of the boost replace the ones of the profile of the same type.
+func MergeProfile(spec autoscaling.StartupCPUBoostSpec,
+	profile *autoscaling.StartupCPUBoostProfileSpec) autoscaling.StartupCPUBoostSpec {
+	result := *spec.DeepCopy()
+	if profile == nil {
+		return result
+	}
+	profile = profile.DeepCopy()
+	result.ResourcePolicy.ContainerPolicies = append(result.ResourcePolicy.ContainerPolicies,
+		profile.ResourcePolicy.ContainerPolicies...)
+	if result.DurationPolicy.Fixed == nil {
+		result.DurationPolicy.Fixed = profile.DurationPolicy.Fixed
+	}
+	if result.DurationPolicy.PodCondition == nil {
+		result.DurationPolicy.PodCondition = profile.DurationPolicy.PodCondition
+	}
+	return result
+}
+
+// ResolveProfile returns the spec of a given boost merged with the referenced profile.
+// The own spec of the boost is returned along with the error when the profile cannot
+// be resolved or its resource policy cannot be mapped.
+func ResolveProfile(ctx context.Context, resolver ProfileResolver,
+	boost *autoscaling.StartupCPUBoost) (autoscaling.StartupCPUBoostSpec, error) {
+	if boost.Spec.ProfileRef == nil {
+		return boost.Spec, nil
+	}
+	profile, err := resolver.Profile(ctx, boost.Namespace, *boost.Spec.ProfileRef)
+	if err != nil {
+		return boost.Spec, err
+	}
+	merged := MergeProfile(boost.Spec, profile)
+	if _, err := mapResourcePolicies(merged.ResourcePolicy); err != nil {
+		return boost.Spec, fmt.Errorf("invalid resource policy of profile %s: %w", boost.Spec.ProfileRef.Name, err)
+	}
+	return merged, nil
+}
+
+// ParseProfileReference parses the profile reference from the annotation value in
+// the name or the kind/name format, i.e. jvm or ClusterStartupCPUBoostProfile/jvm
+func ParseProfileReference(value string) (autoscaling.ProfileReference, error) {
+	ref := autoscaling.ProfileReference{Kind: autoscaling.ProfileKindNamespaced, Name: value}
+	if kind, name, found := strings.Cut(value, "/"); found {
+		ref.Kind, ref.Name = autoscaling.ProfileKind(kind), name
+	}
+	switch {
+	case ref.Kind != autoscaling.ProfileKindNamespaced && ref.Kind != autoscaling.ProfileKindCluster:
+		return ref, fmt.Errorf("unsupported profile kind %q", ref.Kind)
+	case ref.Name == "":
+		return ref, fmt.Errorf("profile name is empty")
+	}
+	return ref, nil
+}
+
+// ReferencesProfile determines if a given boost spec references the profile
+// with a given reference
+func ReferencesProfile(spec autoscaling.StartupCPUBoostSpec, ref autoscaling.ProfileReference) bool {
+	if spec.ProfileRef == nil || spec.ProfileRef.Name != ref.Name {
+		return false
+	}
+	return profileKind(spec.ProfileRef.Kind) == profileKind(ref.Kind)
+}
+
+// profileKind returns a given profile kind with the default applied
+func profileKind(kind autoscaling.ProfileKind) autoscaling.ProfileKind {
+	if kind == "" {
+		return autoscaling.ProfileKindNamespaced
+	}
+	return kind
+}
+
+// podResourcePolicies returns the container policy entries and the fixed duration
+// for a given POD. When the POD references a profile with the annotation, the entries
+// are mapped from the own spec of the boost merged with that profile. The profiles that
+// cannot be used are ignored and reported in the warnings.
+func (b *StartupCPUBoostImpl) podResourcePolicies(ctx context.Context, pod *corev1.Pod,
+	value string) ([]containerPolicyEntry, *time.Duration, []string) {
+	b.RLock()
+	entries, spec, resolver := b.resourcePolicies, b.spec, b.profileResolver
+	_, hasFixedPolicy := b.durationPolicies[duration.FixedDurationPolicyName]
+	b.RUnlock()
+	if value == "" {
+		return entries, nil, nil
+	}
+	ignored := func(err error) []string {
+		return []string{fmt.Sprintf("profile %s is ignored: %s", value, err)}
+	}
+	if resolver == nil {
+		return entries, nil, ignored(fmt.Errorf("profiles are not supported"))
+	}
+	ref, err := ParseProfileReference(value)
+	if err != nil {
+		return entries, nil, ignored(err)
+	}
+	profile, err := resolver.Profile(ctx, pod.Namespace, ref)
+	if err != nil {
+		return entries, nil, ignored(err)
+	}
+	merged := MergeProfile(spec, profile)
+	profileEntries, err := mapResourcePolicies(merged.ResourcePolicy)
+	if err != nil {
+		return entries, nil, ignored(err)
+	}
+	if merged.DurationPolicy.Fixed == nil {
+		return profileEntries, nil, nil
+	}
+	if !hasFixedPolicy {
+		return profileEntries, nil, []string{fmt.Sprintf(
+			"fixed duration policy of profile %s is ignored as the boost has no fixed duration policy", value)}
+	}
+	d := fixedPolicyToDuration(*merged.DurationPolicy.Fixed)
+	return profileEntries, &d, nil
+}
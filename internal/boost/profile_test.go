@@ -0,0 +1,202 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boost_test
+
+import (
+	"context"
+	"errors"
+
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
+	cpuboost "github.com/google/kube-startup-cpu-boost/internal/boost"
+	"github.com/google/kube-startup-cpu-boost/internal/mock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ProfileResolver", func() {
+	var (
+		mockCtrl   *gomock.Controller
+		mockClient *mock.MockClient
+		resolver   cpuboost.ProfileResolver
+		ref        autoscaling.ProfileReference
+		profile    *autoscaling.StartupCPUBoostProfileSpec
+		err        error
+	)
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClient = mock.NewMockClient(mockCtrl)
+		resolver = cpuboost.NewProfileResolver(mockClient)
+	})
+	JustBeforeEach(func() {
+		profile, err = resolver.Profile(context.TODO(), "demo", ref)
+	})
+	When("namespaced profile is referenced", func() {
+		BeforeEach(func() {
+			ref = autoscaling.ProfileReference{Kind: autoscaling.ProfileKindNamespaced, Name: "jvm"}
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(client.ObjectKey{Namespace: "demo", Name: "jvm"}),
+				gomock.AssignableToTypeOf(&autoscaling.StartupCPUBoostProfile{})).
+				DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					obj.(*autoscaling.StartupCPUBoostProfile).Spec.DurationPolicy.Fixed =
+						&autoscaling.FixedDurationPolicy{Unit: autoscaling.FixedDurationPolicyUnitSec, Value: 60}
+					return nil
+				})
+		})
+		It("returns the profile spec from the namespace", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.DurationPolicy.Fixed.Value).To(Equal(int64(60)))
+		})
+	})
+	When("cluster profile is referenced", func() {
+		BeforeEach(func() {
+			ref = autoscaling.ProfileReference{Kind: autoscaling.ProfileKindCluster, Name: "jvm"}
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(client.ObjectKey{Name: "jvm"}),
+				gomock.AssignableToTypeOf(&autoscaling.ClusterStartupCPUBoostProfile{})).
+				DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					obj.(*autoscaling.ClusterStartupCPUBoostProfile).Spec.DurationPolicy.Fixed =
+						&autoscaling.FixedDurationPolicy{Unit: autoscaling.FixedDurationPolicyUnitSec, Value: 90}
+					return nil
+				})
+		})
+		It("returns the cluster profile spec", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.DurationPolicy.Fixed.Value).To(Equal(int64(90)))
+		})
+	})
+	When("referenced profile does not exist", func() {
+		BeforeEach(func() {
+			ref = autoscaling.ProfileReference{Name: "jvm"}
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(apierrors.NewNotFound(schema.GroupResource{Resource: "startupcpuboostprofiles"}, "jvm"))
+		})
+		It("errors", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsNotFound(errors.Unwrap(err))).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("MergeProfile", func() {
+	var (
+		spec    autoscaling.StartupCPUBoostSpec
+		profile *autoscaling.StartupCPUBoostProfileSpec
+		merged  autoscaling.StartupCPUBoostSpec
+	)
+	BeforeEach(func() {
+		spec = autoscaling.StartupCPUBoostSpec{
+			ResourcePolicy: autoscaling.ResourcePolicy{
+				ContainerPolicies: []autoscaling.ContainerPolicy{
+					{
+						MatchContainers:    &autoscaling.MatchContainers{Type: autoscaling.MatchContainersTypeExactName, Value: "app"},
+						PercentageIncrease: &autoscaling.PercentageIncrease{Value: 200},
+					},
+				},
+			},
+			DurationPolicy: autoscaling.DurationPolicy{
+				Fixed: &autoscaling.FixedDurationPolicy{Unit: autoscaling.FixedDurationPolicyUnitSec, Value: 30},
+			},
+		}
+		profile = &autoscaling.StartupCPUBoostProfileSpec{
+			ResourcePolicy: autoscaling.ResourcePolicy{
+				ContainerPolicies: []autoscaling.ContainerPolicy{
+					{
+						MatchContainers:    &autoscaling.MatchContainers{Type: autoscaling.MatchContainersTypeRegexName, Value: ".*"},
+						PercentageIncrease: &autoscaling.PercentageIncrease{Value: 100},
+					},
+				},
+			},
+			DurationPolicy: autoscaling.DurationPolicy{
+				Fixed:        &autoscaling.FixedDurationPolicy{Unit: autoscaling.FixedDurationPolicyUnitMin, Value: 2},
+				PodCondition: &autoscaling.PodConditionDurationPolicy{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+			},
+		}
+	})
+	JustBeforeEach(func() {
+		merged = cpuboost.MergeProfile(spec, profile)
+	})
+	It("places the container policies of the boost first", func() {
+		Expect(merged.ResourcePolicy.ContainerPolicies).To(HaveLen(2))
+		Expect(merged.ResourcePolicy.ContainerPolicies[0].MatchContainers.Value).To(Equal("app"))
+		Expect(merged.ResourcePolicy.ContainerPolicies[1].MatchContainers.Value).To(Equal(".*"))
+	})
+	It("keeps the duration policies of the boost", func() {
+		Expect(merged.DurationPolicy.Fixed.Value).To(Equal(int64(30)))
+	})
+	It("uses the duration policies of the profile not defined by the boost", func() {
+		Expect(merged.DurationPolicy.PodCondition).To(Equal(profile.DurationPolicy.PodCondition))
+	})
+	It("does not modify the boost spec", func() {
+		Expect(spec.ResourcePolicy.ContainerPolicies).To(HaveLen(1))
+		Expect(spec.DurationPolicy.PodCondition).To(BeNil())
+	})
+	When("profile is nil", func() {
+		BeforeEach(func() {
+			profile = nil
+		})
+		It("returns the boost spec", func() {
+			Expect(merged).To(Equal(spec))
+		})
+	})
+})
+
+var _ = Describe("ParseProfileReference", func() {
+	DescribeTable("parses the annotation value",
+		func(value string, expected autoscaling.ProfileReference, expectErr bool) {
+			ref, err := cpuboost.ParseProfileReference(value)
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ref).To(Equal(expected))
+		},
+		Entry("name only", "jvm",
+			autoscaling.ProfileReference{Kind: autoscaling.ProfileKindNamespaced, Name: "jvm"}, false),
+		Entry("namespaced kind and name", "StartupCPUBoostProfile/jvm",
+			autoscaling.ProfileReference{Kind: autoscaling.ProfileKindNamespaced, Name: "jvm"}, false),
+		Entry("cluster kind and name", "ClusterStartupCPUBoostProfile/jvm",
+			autoscaling.ProfileReference{Kind: autoscaling.ProfileKindCluster, Name: "jvm"}, false),
+		Entry("unsupported kind", "Deployment/jvm", autoscaling.ProfileReference{}, true),
+		Entry("empty name", "ClusterStartupCPUBoostProfile/", autoscaling.ProfileReference{}, true),
+		Entry("empty value", "", autoscaling.ProfileReference{}, true),
+	)
+})
+
+var _ = Describe("ReferencesProfile", func() {
+	DescribeTable("determines if the boost references the profile",
+		func(profileRef *autoscaling.ProfileReference, ref autoscaling.ProfileReference, expected bool) {
+			spec := autoscaling.StartupCPUBoostSpec{ProfileRef: profileRef}
+			Expect(cpuboost.ReferencesProfile(spec, ref)).To(Equal(expected))
+		},
+		Entry("no profile reference", nil,
+			autoscaling.ProfileReference{Kind: autoscaling.ProfileKindNamespaced, Name: "jvm"}, false),
+		Entry("same kind and name",
+			&autoscaling.ProfileReference{Kind: autoscaling.ProfileKindCluster, Name: "jvm"},
+			autoscaling.ProfileReference{Kind: autoscaling.ProfileKindCluster, Name: "jvm"}, true),
+		Entry("default kind",
+			&autoscaling.ProfileReference{Name: "jvm"},
+			autoscaling.ProfileReference{Kind: autoscaling.ProfileKindNamespaced, Name: "jvm"}, true),
+		Entry("other kind",
+			&autoscaling.ProfileReference{Kind: autoscaling.ProfileKindCluster, Name: "jvm"},
+			autoscaling.ProfileReference{Kind: autoscaling.ProfileKindNamespaced, Name: "jvm"}, false),
+		Entry("other name",
+			&autoscaling.ProfileReference{Name: "go"},
+			autoscaling.ProfileReference{Kind: autoscaling.ProfileKindNamespaced, Name: "jvm"}, false),
+	)
+})
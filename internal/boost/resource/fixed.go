@@ -23,6 +23,10 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+const (
+	FixedPolicyName = "FixedResources"
+)
+
 type FixedPolicy struct {
 	cpuRequests apiResource.Quantity
 	cpuLimits   apiResource.Quantity
@@ -35,6 +39,10 @@ func NewFixedPolicy(requests apiResource.Quantity, limits apiResource.Quantity)
 	}
 }
 
+func (*FixedPolicy) Name() string {
+	return FixedPolicyName
+}
+
 func (p *FixedPolicy) Requests() apiResource.Quantity {
 	return p.cpuRequests
 }
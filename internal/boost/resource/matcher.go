@@ -16,16 +16,19 @@ package resource
 
 import (
 	"context"
+	"fmt"
 	"regexp"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
-	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+// ContainerMatcher determines if a container is subject to a container policy
 type ContainerMatcher interface {
 	Matches(ctx context.Context, container *corev1.Container) bool
 }
 
+// FixedNameContainerMatcher matches the containers with a given name
 type FixedNameContainerMatcher struct {
 	Name string
 }
@@ -34,18 +37,96 @@ func (m FixedNameContainerMatcher) Matches(ctx context.Context, container *corev
 	return container.Name == m.Name
 }
 
+// RegexNameContainerMatcher matches the containers with names matching
+// a regular expression
 type RegexNameContainerMatcher struct {
-	Expr string
+	expr *regexp.Regexp
 }
 
-func (m RegexNameContainerMatcher) Matches(ctx context.Context, container *corev1.Container) bool {
-	log := ctrl.LoggerFrom(ctx).WithName("regex-container-matcher").
-		WithValues("expr", m.Expr).
-		WithValues("name", container.Name)
-	r, err := regexp.Compile(m.Expr)
+// NewRegexNameContainerMatcher constructs a new RegexNameContainerMatcher
+// with a given regular expression that is compiled once
+func NewRegexNameContainerMatcher(expr string) (*RegexNameContainerMatcher, error) {
+	r, err := regexp.Compile(expr)
 	if err != nil {
-		log.Error(err, "failed to compile regular expression")
+		return nil, fmt.Errorf("invalid container name regular expression: %w", err)
+	}
+	return &RegexNameContainerMatcher{expr: r}, nil
+}
+
+func (m *RegexNameContainerMatcher) Matches(ctx context.Context, container *corev1.Container) bool {
+	return m.expr.MatchString(container.Name)
+}
+
+// ImageContainerMatcher matches the containers with image repositories, i.e. images
+// without the tag and digest, matching a regular expression. The tag and the digest
+// of the image are additionally required to be equal to the given ones, if set.
+type ImageContainerMatcher struct {
+	expr   *regexp.Regexp
+	tag    string
+	digest string
+}
+
+// NewImageContainerMatcher constructs a new ImageContainerMatcher with a given regular
+// expression that is compiled once, and with a given optional tag and digest
+func NewImageContainerMatcher(expr, tag, digest string) (*ImageContainerMatcher, error) {
+	r, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid container image regular expression: %w", err)
+	}
+	return &ImageContainerMatcher{
+		expr:   r,
+		tag:    tag,
+		digest: digest,
+	}, nil
+}
+
+func (m *ImageContainerMatcher) Matches(ctx context.Context, container *corev1.Container) bool {
+	repository, tag, digest := parseImage(container.Image)
+	if m.tag != "" && m.tag != tag {
+		return false
+	}
+	if m.digest != "" && m.digest != digest {
 		return false
 	}
-	return r.MatchString(container.Name)
+	return m.expr.MatchString(repository)
+}
+
+// parseImage splits a given container image reference into the repository, the tag
+// and the digest. The tag defaults to latest when neither tag nor digest is set.
+func parseImage(image string) (repository, tag, digest string) {
+	repository, digest, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:i], repository[i+1:]
+	}
+	if tag == "" && digest == "" {
+		tag = "latest"
+	}
+	return repository, tag, digest
+}
+
+// CompositeContainerMatcher matches the containers matched by any of the include
+// matchers and by none of the exclude matchers. All containers are included when
+// there are no include matchers.
+type CompositeContainerMatcher struct {
+	Include []ContainerMatcher
+	Exclude []ContainerMatcher
+}
+
+func (m CompositeContainerMatcher) Matches(ctx context.Context, container *corev1.Container) bool {
+	included := len(m.Include) == 0
+	for _, matcher := range m.Include {
+		if matcher.Matches(ctx, container) {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return false
+	}
+	for _, matcher := range m.Exclude {
+		if matcher.Matches(ctx, container) {
+			return false
+		}
+	}
+	return true
 }
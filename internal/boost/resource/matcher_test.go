@@ -60,37 +60,25 @@ var _ = Describe("ContainerMatcher", func() {
 		})
 	})
 	Context("with regex name matcher", func() {
-		Context("regex is valid", func() {
+		BeforeEach(func() {
+			var err error
+			matcher, err = resource.NewRegexNameContainerMatcher("container-[0-9]+-.*")
+			Expect(err).NotTo(HaveOccurred())
+		})
+		When("container name matches", func() {
 			BeforeEach(func() {
-				matcher = &resource.RegexNameContainerMatcher{
-					Expr: "container-[0-9]+-.*",
+				container = &corev1.Container{
+					Name: "container-1-test",
 				}
 			})
-			When("container name matches", func() {
-				BeforeEach(func() {
-					container = &corev1.Container{
-						Name: "container-1-test",
-					}
-				})
-				It("returns true", func() {
-					Expect(matches).To(BeTrue())
-				})
-			})
-			When("container name does not match", func() {
-				BeforeEach(func() {
-					container = &corev1.Container{
-						Name: "container-one",
-					}
-				})
-				It("returns false", func() {
-					Expect(matches).To(BeFalse())
-				})
+			It("returns true", func() {
+				Expect(matches).To(BeTrue())
 			})
 		})
-		Context("regex compilation fails", func() {
+		When("container name does not match", func() {
 			BeforeEach(func() {
-				matcher = &resource.RegexNameContainerMatcher{
-					Expr: "container-[0-9++.*",
+				container = &corev1.Container{
+					Name: "container-one",
 				}
 			})
 			It("returns false", func() {
@@ -99,3 +87,78 @@ var _ = Describe("ContainerMatcher", func() {
 		})
 	})
 })
+
+var _ = Describe("ImageContainerMatcher", func() {
+	const expr = "^registry.example.com:5000/base/jvm$"
+	DescribeTable("matches container image",
+		func(image, tag, digest string, expected bool) {
+			matcher, err := resource.NewImageContainerMatcher(expr, tag, digest)
+			Expect(err).NotTo(HaveOccurred())
+			container := &corev1.Container{
+				Name:  "app",
+				Image: image,
+			}
+			Expect(matcher.Matches(context.TODO(), container)).To(Equal(expected))
+		},
+		Entry("repository with tag", "registry.example.com:5000/base/jvm:21-jre", "", "", true),
+		Entry("repository without tag", "registry.example.com:5000/base/jvm", "", "", true),
+		Entry("different repository", "registry.example.com:5000/base/python:3", "", "", false),
+		Entry("equal tag", "registry.example.com:5000/base/jvm:21-jre", "21-jre", "", true),
+		Entry("different tag", "registry.example.com:5000/base/jvm:17-jre", "21-jre", "", false),
+		Entry("implicit latest tag", "registry.example.com:5000/base/jvm", "latest", "", true),
+		Entry("equal digest", "registry.example.com:5000/base/jvm:21-jre@sha256:abc123", "", "sha256:abc123", true),
+		Entry("different digest", "registry.example.com:5000/base/jvm@sha256:def456", "", "sha256:abc123", false),
+		Entry("missing digest", "registry.example.com:5000/base/jvm:21-jre", "", "sha256:abc123", false),
+		Entry("digest without tag", "registry.example.com:5000/base/jvm@sha256:abc123", "latest", "", false),
+	)
+})
+
+var _ = DescribeTable("ContainerMatcher regex compilation fails",
+	func(newMatcher func() (resource.ContainerMatcher, error)) {
+		_, err := newMatcher()
+		Expect(err).To(HaveOccurred())
+	},
+	Entry("with regex name matcher", func() (resource.ContainerMatcher, error) {
+		return resource.NewRegexNameContainerMatcher("container-[0-9++.*")
+	}),
+	Entry("with image matcher", func() (resource.ContainerMatcher, error) {
+		return resource.NewImageContainerMatcher("jvm-[0-9++.*", "", "")
+	}),
+)
+
+var _ = Describe("CompositeContainerMatcher", func() {
+	var (
+		sidecar = resource.FixedNameContainerMatcher{Name: "istio-proxy"}
+		logger  = resource.FixedNameContainerMatcher{Name: "fluent-bit"}
+		app, _  = resource.NewRegexNameContainerMatcher("^app-")
+	)
+	DescribeTable("matches container",
+		func(matcher resource.CompositeContainerMatcher, name string, expected bool) {
+			container := &corev1.Container{Name: name}
+			Expect(matcher.Matches(context.TODO(), container)).To(Equal(expected))
+		},
+		Entry("without rules",
+			resource.CompositeContainerMatcher{}, "app-one", true),
+		Entry("not excluded without include rules",
+			resource.CompositeContainerMatcher{
+				Exclude: []resource.ContainerMatcher{sidecar, logger},
+			}, "app-one", true),
+		Entry("excluded without include rules",
+			resource.CompositeContainerMatcher{
+				Exclude: []resource.ContainerMatcher{sidecar, logger},
+			}, "fluent-bit", false),
+		Entry("included by any rule",
+			resource.CompositeContainerMatcher{
+				Include: []resource.ContainerMatcher{app, sidecar},
+			}, "istio-proxy", true),
+		Entry("not included",
+			resource.CompositeContainerMatcher{
+				Include: []resource.ContainerMatcher{app},
+			}, "fluent-bit", false),
+		Entry("included and excluded",
+			resource.CompositeContainerMatcher{
+				Include: []resource.ContainerMatcher{app},
+				Exclude: []resource.ContainerMatcher{resource.FixedNameContainerMatcher{Name: "app-debug"}},
+			}, "app-debug", false),
+	)
+})
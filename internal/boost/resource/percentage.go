@@ -22,6 +22,10 @@ import (
 	apiResource "k8s.io/apimachinery/pkg/api/resource"
 )
 
+const (
+	PercentageContainerPolicyName = "PercentageIncrease"
+)
+
 type PercentageContainerPolicy struct {
 	percentage int64
 }
@@ -32,6 +36,10 @@ func NewPercentageContainerPolicy(percentage int64) ContainerPolicy {
 	}
 }
 
+func (*PercentageContainerPolicy) Name() string {
+	return PercentageContainerPolicyName
+}
+
 func (p *PercentageContainerPolicy) Percentage() int64 {
 	return p.percentage
 }
@@ -0,0 +1,63 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// PodMatcher determines if the containers of a POD are subject to a container policy
+type PodMatcher interface {
+	Matches(ctx context.Context, pod *corev1.Pod) bool
+}
+
+// PodAttributesMatcher matches the PODs by the attributes. The POD is matched when
+// all the defined attributes match. Empty attributes match any POD.
+type PodAttributesMatcher struct {
+	// Selector matches the POD labels
+	Selector labels.Selector
+	// PriorityClassNames lists the allowed POD priority class names
+	PriorityClassNames []string
+	// OwnerKinds lists the allowed kinds of the POD controller
+	OwnerKinds []string
+	// NodeSelectorKeys lists the keys required in the POD node selector
+	NodeSelectorKeys []string
+}
+
+func (m PodAttributesMatcher) Matches(ctx context.Context, pod *corev1.Pod) bool {
+	if m.Selector != nil && !m.Selector.Matches(labels.Set(pod.Labels)) {
+		return false
+	}
+	if len(m.PriorityClassNames) > 0 && !slices.Contains(m.PriorityClassNames, pod.Spec.PriorityClassName) {
+		return false
+	}
+	if len(m.OwnerKinds) > 0 {
+		owner := metav1.GetControllerOfNoCopy(pod)
+		if owner == nil || !slices.Contains(m.OwnerKinds, owner.Kind) {
+			return false
+		}
+	}
+	for _, key := range m.NodeSelectorKeys {
+		if _, ok := pod.Spec.NodeSelector[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
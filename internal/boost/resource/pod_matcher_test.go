@@ -0,0 +1,79 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"context"
+
+	"github.com/google/kube-startup-cpu-boost/internal/boost/resource"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ = Describe("PodAttributesMatcher", func() {
+	var pod *corev1.Pod
+	BeforeEach(func() {
+		controller := true
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "demo-5d8f7b9c4-abcde",
+				Labels: map[string]string{"tier": "critical"},
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "ReplicaSet", Name: "demo-5d8f7b9c4", Controller: &controller},
+				},
+			},
+			Spec: corev1.PodSpec{
+				PriorityClassName: "high-priority",
+				NodeSelector:      map[string]string{"cloud.google.com/gke-nodepool": "pool-1"},
+			},
+		}
+	})
+	DescribeTable("matches POD",
+		func(matcher resource.PodAttributesMatcher, expected bool) {
+			Expect(matcher.Matches(context.TODO(), pod)).To(Equal(expected))
+		},
+		Entry("without attributes", resource.PodAttributesMatcher{}, true),
+		Entry("with matching attributes", resource.PodAttributesMatcher{
+			Selector:           labels.SelectorFromSet(labels.Set{"tier": "critical"}),
+			PriorityClassNames: []string{"low-priority", "high-priority"},
+			OwnerKinds:         []string{"ReplicaSet"},
+			NodeSelectorKeys:   []string{"cloud.google.com/gke-nodepool"},
+		}, true),
+		Entry("with not matching selector", resource.PodAttributesMatcher{
+			Selector: labels.SelectorFromSet(labels.Set{"tier": "batch"}),
+		}, false),
+		Entry("with not matching priority class", resource.PodAttributesMatcher{
+			PriorityClassNames: []string{"low-priority"},
+		}, false),
+		Entry("with not matching owner kind", resource.PodAttributesMatcher{
+			OwnerKinds: []string{"Job"},
+		}, false),
+		Entry("with missing node selector key", resource.PodAttributesMatcher{
+			NodeSelectorKeys: []string{"cloud.google.com/gke-nodepool", "cloud.google.com/gke-spot"},
+		}, false),
+	)
+	When("POD has no controller", func() {
+		BeforeEach(func() {
+			pod.OwnerReferences = nil
+		})
+		It("does not match owner kind", func() {
+			matcher := resource.PodAttributesMatcher{OwnerKinds: []string{"ReplicaSet"}}
+			Expect(matcher.Matches(context.TODO(), pod)).To(BeFalse())
+		})
+	})
+})
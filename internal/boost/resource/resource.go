@@ -22,5 +22,6 @@ import (
 )
 
 type ContainerPolicy interface {
+	Name() string
 	NewResources(ctx context.Context, container *corev1.Container) *corev1.ResourceRequirements
 }
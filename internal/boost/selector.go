@@ -0,0 +1,104 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boost
+
+import (
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// keyConstraint holds the combined constraints of label selector requirements
+// on a single label key
+type keyConstraint struct {
+	mustExist    bool
+	mustNotExist bool
+	// allowed holds the values allowed for the key, nil means any value
+	allowed  sets.Set[string]
+	excluded sets.Set[string]
+}
+
+// SelectorsOverlap determines if there is a set of labels matched by both
+// of the given selectors, i.e. if a POD can be matched by two boosts.
+// Numeric comparisons are considered as satisfiable by any existing label.
+func SelectorsOverlap(a, b labels.Selector) bool {
+	constraints := make(map[string]*keyConstraint)
+	for _, selector := range []labels.Selector{a, b} {
+		requirements, selectable := selector.Requirements()
+		if !selectable {
+			return false
+		}
+		for _, req := range requirements {
+			constraint, ok := constraints[req.Key()]
+			if !ok {
+				constraint = &keyConstraint{excluded: sets.New[string]()}
+				constraints[req.Key()] = constraint
+			}
+			constraint.add(req)
+		}
+	}
+	for _, constraint := range constraints {
+		if !constraint.satisfiable() {
+			return false
+		}
+	}
+	return true
+}
+
+// TargetsOverlap determines if there is a POD matched by both of the boosts with the
+// given label selectors and target references. The boosts with target references
+// overlap when they refer to the same object. Otherwise the label selectors are
+// compared, as the selector of a boost with target reference is empty and the
+// labels of the target PODs are not known.
+func TargetsOverlap(a labels.Selector, aRef *autoscalingv1.CrossVersionObjectReference,
+	b labels.Selector, bRef *autoscalingv1.CrossVersionObjectReference) bool {
+	if aRef != nil && bRef != nil {
+		return sameTarget(aRef, bRef)
+	}
+	return SelectorsOverlap(a, b)
+}
+
+// add narrows the constraint with a given requirement
+func (c *keyConstraint) add(req labels.Requirement) {
+	values := req.Values().UnsortedList()
+	switch req.Operator() {
+	case selection.In, selection.Equals, selection.DoubleEquals:
+		c.mustExist = true
+		if c.allowed == nil {
+			c.allowed = sets.New(values...)
+		} else {
+			c.allowed = c.allowed.Intersection(sets.New(values...))
+		}
+	case selection.NotIn, selection.NotEquals:
+		c.excluded.Insert(values...)
+	case selection.Exists, selection.GreaterThan, selection.LessThan:
+		c.mustExist = true
+	case selection.DoesNotExist:
+		c.mustNotExist = true
+	}
+}
+
+// satisfiable determines if there is a label value, or lack of the label,
+// that meets the constraint
+func (c *keyConstraint) satisfiable() bool {
+	if c.mustNotExist {
+		return !c.mustExist
+	}
+	if c.allowed == nil {
+		return true
+	}
+	return c.allowed.Difference(c.excluded).Len() > 0
+}
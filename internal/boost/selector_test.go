@@ -0,0 +1,68 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package boost_test
+
+import (
+	cpuboost "github.com/google/kube-startup-cpu-boost/internal/boost"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ = Describe("SelectorsOverlap", func() {
+	DescribeTable("determines if selectors match a common set of labels",
+		func(a, b string, expected bool) {
+			selectorA, err := labels.Parse(a)
+			Expect(err).NotTo(HaveOccurred())
+			selectorB, err := labels.Parse(b)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cpuboost.SelectorsOverlap(selectorA, selectorB)).To(Equal(expected))
+		},
+		Entry("empty selectors", "", "", true),
+		Entry("empty and non empty selector", "", "app=one", true),
+		Entry("equal labels", "app=one", "app=one", true),
+		Entry("different label values", "app=one", "app=two", false),
+		Entry("different label keys", "app=one", "tier=web", true),
+		Entry("intersecting sets", "app in (one,two)", "app in (two,three)", true),
+		Entry("disjoint sets", "app in (one,two)", "app in (three)", false),
+		Entry("excluded value", "app=one", "app notin (one)", false),
+		Entry("partially excluded set", "app in (one,two)", "app!=one", true),
+		Entry("exists and does not exist", "app", "!app", false),
+		Entry("does not exist and excluded value", "!app", "app!=one", true),
+	)
+})
+
+var _ = Describe("TargetsOverlap", func() {
+	var (
+		demo = &autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "demo"}
+		app  = labels.SelectorFromSet(labels.Set{"app": "demo"})
+	)
+	DescribeTable("determines if boosts match a common POD",
+		func(a labels.Selector, aRef *autoscalingv1.CrossVersionObjectReference,
+			b labels.Selector, bRef *autoscalingv1.CrossVersionObjectReference, expected bool) {
+			Expect(cpuboost.TargetsOverlap(a, aRef, b, bRef)).To(Equal(expected))
+		},
+		Entry("equal target references", labels.Everything(), demo, labels.Everything(), demo, true),
+		Entry("target references with different versions", labels.Everything(), demo, labels.Everything(),
+			&autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1beta2", Kind: "Deployment", Name: "demo"}, true),
+		Entry("target references with different names", labels.Everything(), demo, labels.Everything(),
+			&autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "other"}, false),
+		Entry("target references with different kinds", labels.Everything(), demo, labels.Everything(),
+			&autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "demo"}, false),
+		Entry("target reference and selector", labels.Everything(), demo, app, nil, true),
+		Entry("selectors", app, nil, labels.SelectorFromSet(labels.Set{"app": "other"}), nil, false),
+	)
+})
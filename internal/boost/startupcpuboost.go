@@ -18,17 +18,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
-	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	"github.com/google/kube-startup-cpu-boost/internal/boost/duration"
 	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
 	"github.com/google/kube-startup-cpu-boost/internal/boost/resource"
 	"github.com/google/kube-startup-cpu-boost/internal/metrics"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -42,8 +51,9 @@ type StartupCPUBoost interface {
 	// Namespace returns startup-cpu-boost namespace
 	Namespace() string
 
-	// ApplyResourcePolicy applies resource policy on a given POD
-	ApplyResourcePolicy(ctx context.Context, pod *corev1.Pod) error
+	// ApplyResourcePolicy applies resource policy on a given POD with the settings
+	// overridden by the POD and namespace annotations
+	ApplyResourcePolicy(ctx context.Context, pod *corev1.Pod, overrides bpod.BoostOverrides) (ResourcePolicyResult, error)
 	// DurationPolicies returns configured duration policies
 	DurationPolicies() map[string]duration.Policy
 	// Pod returns a POD if tracked by startup-cpu-boost
@@ -57,8 +67,23 @@ type StartupCPUBoost interface {
 	// RevertResources updates POD's container resource requests and limits to their original
 	// values using the data from StartupCPUBoost annotation
 	RevertResources(ctx context.Context, pod *corev1.Pod) error
-	// Matches verifies if a boost selector matches the given POD
-	Matches(pod *corev1.Pod) bool
+	// Matches verifies if a boost selector and target reference match the given POD
+	Matches(ctx context.Context, pod *corev1.Pod) bool
+	// Selector returns the boost POD label selector
+	Selector() labels.Selector
+	// TargetRef returns the reference to the workload controlling the boost PODs,
+	// or nil when the PODs are selected only by the label selector
+	TargetRef() *autoscalingv1.CrossVersionObjectReference
+	// Priority returns the boost priority used when multiple boosts match a POD
+	Priority() int32
+	// Suspended returns true when the boost is suspended and does not boost new PODs
+	Suspended() bool
+	// ProfileError returns the error of the referenced profile resolution, or nil when
+	// the profile is resolved or not referenced
+	ProfileError() error
+	// ResolveProfile resolves the referenced profile again and updates the boost policies
+	// when it is resolved. The resolution error is returned otherwise.
+	ResolveProfile(ctx context.Context) error
 	// Stats returns the StartupCPUBoost usage statistics
 	Stats() StartupCPUBoostStats
 	// UpdateFromSpec updates the StartupCPUBoost from the API spec
@@ -70,12 +95,19 @@ const (
 	StartupCPUBoostStatsPodUpdateEvent = 2
 	StartupCPUBoostStatsPodDeleteEvent = 3
 	ResizeSubResourceName              = "resize"
+	FieldManagerName                   = "kube-startup-cpu-boost"
 )
 
 var (
 	ErrNilBoost  = errors.New("boost spec cannot be nil")
 	ErrNilConfig = errors.New("config cannot be nil")
 	ErrNilClient = errors.New("k8s client cannot be nil")
+	// ErrNilOwnerResolver is returned when the boost has target reference and
+	// the owner resolver is not configured
+	ErrNilOwnerResolver = errors.New("owner resolver cannot be nil for boost with target reference")
+	// ErrNilProfileResolver is returned when the boost has profile reference and
+	// the profile resolver is not configured
+	ErrNilProfileResolver = errors.New("profile resolver cannot be nil for boost with profile reference")
 )
 
 type StartupCPUBoostStatsEventType int32
@@ -93,11 +125,73 @@ type StartupCPUBoostStats struct {
 	// totalContainerBoosts is a number of a containers which CPU resources
 	// were increased (boosted)
 	TotalContainerBoosts int
+	// DeferredContainerBoosts is a number of a boosted containers which in-place
+	// resize is deferred by the kubelet
+	DeferredContainerBoosts int
+	// InfeasibleContainerBoosts is a number of a boosted containers which in-place
+	// resize was found infeasible by the kubelet
+	InfeasibleContainerBoosts int
+	// SkippedContainerBoosts is a number of a containers that matched the resource
+	// policy but were not boosted, by the reason of the skip
+	SkippedContainerBoosts map[string]int
+	// FailedReverts is a number of a tracked PODs which last resources reversion failed
+	FailedReverts int
+	// LastRevertError is the error of the most recent failed resources reversion
+	LastRevertError string
+	// ActivePods holds the boost details of a tracked PODs ordered by the boost time
+	ActivePods []PodBoostStats
+	// ExtraCPU is the CPU granted on top of the original CPU requests of
+	// the boosted containers which CPU resources were not yet reverted
+	ExtraCPU apiResource.Quantity
+	// ExtraCPUCoreSeconds is a number of extra CPU core-seconds granted to the
+	// boosted containers from the boost to the revert
+	ExtraCPUCoreSeconds float64
+	// DryRunContainerBoosts is a number of a containers which CPU resources
+	// would be increased (boosted) in the DryRun mode
+	DryRunContainerBoosts int
+	// DryRunExtraCPU is the CPU that would be granted in the DryRun mode on
+	// top of the original CPU requests of the containers
+	DryRunExtraCPU apiResource.Quantity
+}
+
+// ResourcePolicyResult holds the outcome of applying the resource policy on a POD
+type ResourcePolicyResult struct {
+	// SkippedContainers hold the containers matched by the resource policy
+	// that were not boosted, in the POD spec order
+	SkippedContainers []SkippedContainer
+	// Warnings hold the messages on the annotation overrides that were ignored
+	Warnings []string
+	// DryRun is true when the POD resources were not changed as the boost is
+	// in the DryRun mode
+	DryRun bool
+}
+
+// SkippedContainer describes a container matched by the resource policy
+// that was not boosted
+type SkippedContainer struct {
+	// Name is the name of the container
+	Name string
+	// Reason is the event reason of the skip
+	Reason string
+	// Message is the human readable explanation of the skip
+	Message string
+}
+
+// PodBoostStats holds the boost details of a tracked POD
+type PodBoostStats struct {
+	// Name is the name of the POD
+	Name string
+	// BoostTime is the time when the POD resources were boosted
+	BoostTime time.Time
+	// ExpectedRevertTime is the time when the POD resources are expected to be
+	// reverted. It is nil when the boost has no fixed duration policy.
+	ExpectedRevertTime *time.Time
 }
 
 type containerPolicyEntry struct {
-	matcher resource.ContainerMatcher
-	policy  resource.ContainerPolicy
+	matcher    resource.ContainerMatcher
+	podMatcher resource.PodMatcher
+	policy     resource.ContainerPolicy
 }
 
 // StartupCPUBoostImpl is an implementation of a StartupCPUBoost CRD
@@ -105,12 +199,34 @@ type StartupCPUBoostImpl struct {
 	sync.RWMutex
 	name                     string
 	namespace                string
+	generation               int64
+	object                   *autoscaling.StartupCPUBoost
 	selector                 labels.Selector
+	targetRef                *autoscalingv1.CrossVersionObjectReference
+	ownerResolver            OwnerResolver
+	profileResolver          ProfileResolver
+	profileErr               error
+	spec                     autoscaling.StartupCPUBoostSpec
+	priority                 int32
+	suspended                bool
+	mode                     autoscaling.BoostMode
 	durationPolicies         map[string]duration.Policy
+	overridePolicy           *autoscaling.OverridePolicy
+	durationOverrides        map[string]time.Duration
 	resourcePolicies         []containerPolicyEntry
 	pods                     map[string]*corev1.Pod
+	revertPending            map[string]bool
+	dryRunPods               map[types.UID]bool
+	skippedPods              map[types.UID]bool
+	revertErrors             map[string]string
+	startupObserved          map[string]bool
 	client                   client.Client
+	recorder                 events.EventRecorder
+	driftPolicy              autoscaling.DriftPolicy
 	stats                    StartupCPUBoostStats
+	totalSeedTime            time.Time
+	skippedSeedTime          time.Time
+	dryRunSeedTime           time.Time
 	legacyRevertMode         bool
 	boostOnRestart           bool
 	podLevelResourcesEnabled bool
@@ -121,6 +237,8 @@ type StartupCPUBoostImpl struct {
 type StartupCPUBoostConfig struct {
 	// Client is a k8s client
 	Client client.Client
+	// EventRecorder records the boost events on PODs. Events are not recorded when nil
+	EventRecorder events.EventRecorder
 	// LegacyRevertMode controls if pre k8s resource reversion mode should be used
 	LegacyRevertMode bool
 	// BoostOnRestart controls if POD resources should be boosted on container restarts
@@ -129,6 +247,10 @@ type StartupCPUBoostConfig struct {
 	PodLevelResourcesEnabled bool
 	// RemoveLimitsEnabled controls if cpu limits should be removed when boosting
 	RemoveLimitsEnabled bool
+	// OwnerResolver resolves the POD controllers for boosts with target reference
+	OwnerResolver OwnerResolver
+	// ProfileResolver resolves the profiles referenced by the boosts and the PODs
+	ProfileResolver ProfileResolver
 }
 
 // Validate validates the configuration
@@ -142,36 +264,80 @@ func (c *StartupCPUBoostConfig) Validate() error {
 	return nil
 }
 
-// NewStartupCPUBoost constructs startup-cpu-boost implementation from a given API spec
-func NewStartupCPUBoost(boost *autoscaling.StartupCPUBoost, cfg *StartupCPUBoostConfig) (StartupCPUBoost, error) {
+// ValidateSpec validates if the StartupCPUBoost API spec can be mapped to the
+// boost implementation
+func ValidateSpec(boost *autoscaling.StartupCPUBoost) error {
+	if boost == nil {
+		return ErrNilBoost
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&boost.Spec.Selector); err != nil {
+		return err
+	}
+	_, err := mapResourcePolicies(boost.Spec.ResourcePolicy)
+	return err
+}
+
+// NewStartupCPUBoost constructs startup-cpu-boost implementation from a given API spec.
+// The boost which referenced profile cannot be resolved is constructed with the resolution
+// error returned by ProfileError, and does not boost new PODs until it is resolved.
+func NewStartupCPUBoost(ctx context.Context, boost *autoscaling.StartupCPUBoost,
+	cfg *StartupCPUBoostConfig) (StartupCPUBoost, error) {
 	if boost == nil {
 		return nil, ErrNilBoost
 	}
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
-	selector, err := metav1.LabelSelectorAsSelector(&boost.Selector)
+	selector, err := metav1.LabelSelectorAsSelector(&boost.Spec.Selector)
 	if err != nil {
 		return nil, err
 	}
-	resourcePolicies, err := mapResourcePolicies(boost.Spec.ResourcePolicy)
+	if boost.Spec.TargetRef != nil && cfg.OwnerResolver == nil {
+		return nil, ErrNilOwnerResolver
+	}
+	if boost.Spec.ProfileRef != nil && cfg.ProfileResolver == nil {
+		return nil, ErrNilProfileResolver
+	}
+	spec, profileErr := ResolveProfile(ctx, cfg.ProfileResolver, boost)
+	resourcePolicies, err := mapResourcePolicies(spec.ResourcePolicy)
 	if err != nil {
 		return nil, err
 	}
-	return &StartupCPUBoostImpl{
+	b := &StartupCPUBoostImpl{
 		name:                     boost.Name,
 		namespace:                boost.Namespace,
+		generation:               boost.Generation,
+		object:                   boostReference(boost),
 		selector:                 selector,
-		durationPolicies:         mapDurationPolicy(boost.Spec.DurationPolicy),
+		targetRef:                boost.Spec.TargetRef.DeepCopy(),
+		ownerResolver:            cfg.OwnerResolver,
+		profileResolver:          cfg.ProfileResolver,
+		profileErr:               profileErr,
+		spec:                     *boost.Spec.DeepCopy(),
+		priority:                 boost.Spec.Priority,
+		suspended:                boost.Spec.Suspend,
+		mode:                     boost.Spec.Mode,
+		durationPolicies:         mapDurationPolicy(spec.DurationPolicy),
+		overridePolicy:           boost.Spec.Overrides.DeepCopy(),
+		durationOverrides:        make(map[string]time.Duration),
 		resourcePolicies:         resourcePolicies,
 		pods:                     make(map[string]*corev1.Pod),
+		revertPending:            make(map[string]bool),
+		dryRunPods:               make(map[types.UID]bool),
+		skippedPods:              make(map[types.UID]bool),
+		revertErrors:             make(map[string]string),
+		startupObserved:          make(map[string]bool),
 		client:                   cfg.Client,
-		stats:                    StartupCPUBoostStats{},
+		recorder:                 cfg.EventRecorder,
+		driftPolicy:              boost.Spec.DriftPolicy,
+		stats:                    StartupCPUBoostStats{SkippedContainerBoosts: make(map[string]int)},
 		legacyRevertMode:         cfg.LegacyRevertMode,
 		boostOnRestart:           cfg.BoostOnRestart,
 		podLevelResourcesEnabled: cfg.PodLevelResourcesEnabled,
 		removeLimitsEnabled:      cfg.RemoveLimitsEnabled,
-	}, nil
+	}
+	b.seedStats(boost.Status)
+	return b, nil
 }
 
 // Name returns startup-cpu-boost name
@@ -184,42 +350,90 @@ func (b *StartupCPUBoostImpl) Namespace() string {
 	return b.namespace
 }
 
-// ApplyResourcePolicy applies resource policy on a given POD
-func (b *StartupCPUBoostImpl) ApplyResourcePolicy(ctx context.Context, pod *corev1.Pod) error {
+// ApplyResourcePolicy applies resource policy on a given POD with the settings
+// overridden by the POD and namespace annotations. The overrides outside of the
+// bounds of the boost override policy are ignored and reported in the warnings.
+// In the DryRun mode, the POD resources are not changed and the boost that would
+// be applied is described in the POD annotation and the event.
+func (b *StartupCPUBoostImpl) ApplyResourcePolicy(ctx context.Context, pod *corev1.Pod,
+	overrides bpod.BoostOverrides) (ResourcePolicyResult, error) {
+	var result ResourcePolicyResult
 	log := b.loggerFromContext(ctx)
+	allowed, warnings := b.allowedOverrides(overrides)
+	result.Warnings = warnings
+	entries, profileDuration, warnings := b.podResourcePolicies(ctx, pod, overrides.Profile)
+	result.Warnings = append(result.Warnings, warnings...)
+	b.RLock()
+	result.DryRun = b.mode == autoscaling.BoostModeDryRun
+	generation := b.generation
+	b.RUnlock()
+	// the boosted resources are set on the copy of the POD in the DryRun mode
+	boostedPod := pod
+	if result.DryRun {
+		if _, ok := pod.Annotations[bpod.DryRunAnnotationKey]; ok {
+			// the POD was already evaluated when the webhook is reinvoked
+			return result, nil
+		}
+		boostedPod = pod.DeepCopy()
+	}
 	originalQosClass := bpod.ComputePodQOS(pod, b.podLevelResourcesEnabled)
 	annotation := bpod.NewBoostAnnotation()
 	if _, ok := pod.Annotations[bpod.BoostAnnotationKey]; ok {
 		var err error
 		annotation, err = bpod.BoostAnnotationFromPod(pod)
 		if err != nil {
-			return err
+			return result, err
 		}
 	}
 	// ToDo: add validation based on boost annotation status
+	// the POD can be already boosted when the webhook is reinvoked, so only the
+	// containers not recorded in the annotation are considered
+	alreadyBoosted := annotation.HasInitCPUResources()
+	boostedCnt := 0
+	skipped := make(map[string]string)
+	skip := func(containerName, reason, message string) {
+		skipped[containerName] = reason
+		result.SkippedContainers = append(result.SkippedContainers, SkippedContainer{
+			Name:    containerName,
+			Reason:  reason,
+			Message: message,
+		})
+	}
 	for i, container := range pod.Spec.Containers {
-		policy, found := b.resourcePolicy(ctx, &container)
+		if annotation.HasContainer(container.Name) {
+			continue
+		}
+		policy, found := resourcePolicy(ctx, entries, pod, &container)
 		if !found {
 			continue
 		}
+		if _, ok := policy.(*resource.PercentageContainerPolicy); ok && allowed.percentage != nil {
+			policy = resource.NewPercentageContainerPolicy(*allowed.percentage)
+		}
 		log = log.WithValues("container", container.Name,
 			"cpuRequests", container.Resources.Requests.Cpu().String(),
 			"cpuLimits", container.Resources.Limits.Cpu().String(),
 		)
 		if bpod.ResourceResizeRequiresRestart(container, corev1.ResourceCPU) {
 			log.Info("skipping container due to restart policy")
+			skip(container.Name, EventReasonSkippedRestartPolicy,
+				skippedContainerReasons[EventReasonSkippedRestartPolicy])
 			continue
 		}
 		if !bpod.HasCPUResourcesToIncrease(container) {
 			log.Info("skipping container due to lack of CPU resources to increase")
+			skip(container.Name, EventReasonSkippedNoCPUResources,
+				skippedContainerReasons[EventReasonSkippedNoCPUResources])
 			continue
 		}
 		resources := policy.NewResources(ctx, &container)
-		tmpUpdatedPod := pod.DeepCopy()
+		tmpUpdatedPod := boostedPod.DeepCopy()
 		tmpUpdatedPod.Spec.Containers[i].Resources = *resources
 		tmpNewQosClass := bpod.ComputePodQOS(tmpUpdatedPod, b.podLevelResourcesEnabled)
 		if tmpNewQosClass != originalQosClass {
 			log.Info("skipping container due to QOS class change after boost")
+			skip(container.Name, EventReasonSkippedQoSChange,
+				fmt.Sprintf("QoS would change from %s to %s", originalQosClass, tmpNewQosClass))
 			continue
 		}
 		if !resources.Requests.Cpu().IsZero() {
@@ -236,18 +450,55 @@ func (b *StartupCPUBoostImpl) ApplyResourcePolicy(ctx context.Context, pod *core
 			}
 		}
 		annotation.UpdateInitResources(container.Name, container.Resources)
-		pod.Spec.Containers[i].Resources = *resources
+		annotation.UpdateBoostedResources(container.Name, *resources)
+		annotation.UpdateResourcePolicy(container.Name, policy.Name())
+		boostedPod.Spec.Containers[i].Resources = *resources
+		boostedCnt++
 		log.Info("container resources increased")
 	}
+	if result.DryRun {
+		b.applyDryRun(pod, annotation, skipped, originalQosClass)
+		return result, nil
+	}
+	if alreadyBoosted && boostedCnt == 0 && len(skipped) == 0 {
+		return result, nil
+	}
 	// checks if any container CPU resources were boosted
 	if annotation.HasInitCPUResources() {
-		annotation.State = bpod.BoostStateActive
-		annotation.BoostTimestamp = time.Now()
+		if !alreadyBoosted {
+			annotation.BoostName = b.name
+			annotation.BoostGeneration = generation
+			annotation.SetState(bpod.BoostStateActive, bpod.BoostReasonBoosted, "")
+			annotation.BoostTimestamp = time.Now()
+			if allowed.duration != nil {
+				annotation.FixedDuration = allowed.duration.String()
+			} else if profileDuration != nil {
+				annotation.FixedDuration = profileDuration.String()
+			}
+		}
+		for name, reason := range skipped {
+			annotation.UpdateSkippedContainer(name, reason)
+		}
 		annotation.Apply(pod)
 		label := &bpod.BoostPodLabel{BoostName: b.Name()}
 		label.Apply(pod)
+		return result, nil
 	}
-	return nil
+	if len(skipped) == 0 {
+		return result, nil
+	}
+	// the skipped containers are recorded in the annotation, so they are not
+	// reported again when the webhook is reinvoked, and the POD is labelled, so
+	// they are accounted once the POD is created
+	annotation.BoostName = b.name
+	annotation.BoostGeneration = generation
+	for name, reason := range skipped {
+		annotation.UpdateSkippedContainer(name, reason)
+	}
+	annotation.Apply(pod)
+	label := &bpod.BoostPodLabel{BoostName: b.Name()}
+	label.Apply(pod)
+	return result, nil
 }
 
 // DurationPolicies returns configured duration policies
@@ -268,6 +519,9 @@ func (b *StartupCPUBoostImpl) HandlePodEvent(ctx context.Context, event *bpod.Po
 	if err := event.Validate(); err != nil {
 		return err
 	}
+	if _, ok := event.Pod.Annotations[bpod.DryRunAnnotationKey]; ok {
+		return b.handleDryRunPodEvent(ctx, event)
+	}
 	switch event.Type {
 	case bpod.PodEventTypePodCreated:
 		return b.upsertPod(ctx, event.Pod)
@@ -275,6 +529,8 @@ func (b *StartupCPUBoostImpl) HandlePodEvent(ctx context.Context, event *bpod.Po
 		return b.deletePod(ctx, event.Pod)
 	case bpod.PodEventTypeConditionChanged:
 		return b.upsertPod(ctx, event.Pod)
+	case bpod.PodEventTypeResourcesChanged:
+		return b.upsertPod(ctx, event.Pod)
 	default:
 		log := b.loggerFromContext(ctx).WithValues("event_type", event.Type, "pod", event.Pod.Name)
 		log.Info("unknown event type, skipping")
@@ -293,7 +549,10 @@ func (b *StartupCPUBoostImpl) ValidatePolicy(ctx context.Context, name string) (
 		return
 	}
 	for _, pod := range b.pods {
-		if !b.validatePolicyOnPod(ctx, policy, pod) {
+		if b.revertPending[pod.Name] {
+			continue
+		}
+		if !b.validatePolicyOnPod(ctx, b.podDurationPolicy(policy, pod), pod) {
 			violated = append(violated, pod)
 		}
 	}
@@ -313,41 +572,161 @@ func (b *StartupCPUBoostImpl) RevertResources(ctx context.Context, pod *corev1.P
 	return b.revertResources(ctx, pod)
 }
 
-// Matches verifies if a boost selector matches the given POD
-func (b *StartupCPUBoostImpl) Matches(pod *corev1.Pod) bool {
-	return b.selector.Matches(labels.Set(pod.Labels))
+// Matches verifies if a boost selector and target reference match the given POD.
+// The POD controllers are resolved for the boost with target reference.
+func (b *StartupCPUBoostImpl) Matches(ctx context.Context, pod *corev1.Pod) bool {
+	b.RLock()
+	selector, targetRef := b.selector, b.targetRef
+	b.RUnlock()
+	if !selector.Matches(labels.Set(pod.Labels)) {
+		return false
+	}
+	if targetRef == nil {
+		return true
+	}
+	controlled, err := b.ownerResolver.IsControlledBy(ctx, pod, targetRef)
+	if err != nil {
+		b.loggerFromContext(ctx).Error(err, "failed to resolve pod controllers", "pod", pod.Name)
+		return false
+	}
+	return controlled
+}
+
+// Selector returns the boost POD label selector
+func (b *StartupCPUBoostImpl) Selector() labels.Selector {
+	b.RLock()
+	defer b.RUnlock()
+	return b.selector
+}
+
+// TargetRef returns the reference to the workload controlling the boost PODs,
+// or nil when the PODs are selected only by the label selector
+func (b *StartupCPUBoostImpl) TargetRef() *autoscalingv1.CrossVersionObjectReference {
+	b.RLock()
+	defer b.RUnlock()
+	return b.targetRef
+}
+
+// Priority returns the boost priority used when multiple boosts match a POD
+func (b *StartupCPUBoostImpl) Priority() int32 {
+	b.RLock()
+	defer b.RUnlock()
+	return b.priority
+}
+
+// Suspended returns true when the boost is suspended and does not boost new PODs.
+// The PODs boosted before the suspension are still tracked and reverted.
+func (b *StartupCPUBoostImpl) Suspended() bool {
+	b.RLock()
+	defer b.RUnlock()
+	return b.suspended
+}
+
+// ProfileError returns the error of the referenced profile resolution, or nil when
+// the profile is resolved or not referenced
+func (b *StartupCPUBoostImpl) ProfileError() error {
+	b.RLock()
+	defer b.RUnlock()
+	return b.profileErr
+}
+
+// ResolveProfile resolves the referenced profile again and updates the resource and
+// duration policies of the boost when it is resolved
+func (b *StartupCPUBoostImpl) ResolveProfile(ctx context.Context) error {
+	b.RLock()
+	boost := &autoscaling.StartupCPUBoost{
+		ObjectMeta: metav1.ObjectMeta{Name: b.name, Namespace: b.namespace},
+		Spec:       *b.spec.DeepCopy(),
+	}
+	resolver := b.profileResolver
+	b.RUnlock()
+	if boost.Spec.ProfileRef != nil && resolver == nil {
+		return ErrNilProfileResolver
+	}
+	spec, profileErr := ResolveProfile(ctx, resolver, boost)
+	b.Lock()
+	defer b.Unlock()
+	if !equality.Semantic.DeepEqual(b.spec, boost.Spec) {
+		// the boost was updated meanwhile, with the profile resolved again
+		return b.profileErr
+	}
+	if profileErr != nil {
+		b.profileErr = profileErr
+		return profileErr
+	}
+	resourcePolicies, err := mapResourcePolicies(spec.ResourcePolicy)
+	if err != nil {
+		return err
+	}
+	b.resourcePolicies = resourcePolicies
+	b.durationPolicies = mapDurationPolicy(spec.DurationPolicy)
+	b.profileErr = nil
+	return nil
 }
 
 // Stats returns the StartupCPUBoost usage statistics
 func (b *StartupCPUBoostImpl) Stats() StartupCPUBoostStats {
-	return b.stats
+	b.RLock()
+	defer b.RUnlock()
+	stats := b.stats
+	stats.SkippedContainerBoosts = maps.Clone(b.stats.SkippedContainerBoosts)
+	stats.ExtraCPU = b.stats.ExtraCPU.DeepCopy()
+	stats.DryRunExtraCPU = b.stats.DryRunExtraCPU.DeepCopy()
+	stats.ActivePods = b.activePodsStats()
+	return stats
 }
 
 // UpdateFromSpec updates the StartupCPUBoost from the API spec
 func (b *StartupCPUBoostImpl) UpdateFromSpec(ctx context.Context, boost *autoscaling.StartupCPUBoost) error {
-	b.Lock()
-	defer b.Unlock()
 	log := b.loggerFromContext(ctx)
 	log.V(5).Info("handling boost update from API spec")
-	selector, err := metav1.LabelSelectorAsSelector(&boost.Selector)
+	if boost.Spec.ProfileRef != nil && b.profileResolver == nil {
+		return ErrNilProfileResolver
+	}
+	// the profile is resolved before locking the boost as it may need to be read
+	// from the API server
+	spec, profileErr := ResolveProfile(ctx, b.profileResolver, boost)
+	if profileErr != nil {
+		log.Error(profileErr, "failed to resolve boost profile, new PODs are not boosted until it is resolved")
+	}
+	b.Lock()
+	defer b.Unlock()
+	selector, err := metav1.LabelSelectorAsSelector(&boost.Spec.Selector)
 	if err != nil {
 		return err
 	}
-	resourcePolicies, err := mapResourcePolicies(boost.Spec.ResourcePolicy)
+	if boost.Spec.TargetRef != nil && b.ownerResolver == nil {
+		return ErrNilOwnerResolver
+	}
+	resourcePolicies, err := mapResourcePolicies(spec.ResourcePolicy)
 	if err != nil {
 		return err
 	}
 	b.selector = selector
+	b.targetRef = boost.Spec.TargetRef.DeepCopy()
+	b.spec = *boost.Spec.DeepCopy()
+	b.priority = boost.Spec.Priority
+	b.suspended = boost.Spec.Suspend
+	b.mode = boost.Spec.Mode
 	b.resourcePolicies = resourcePolicies
-	b.durationPolicies = mapDurationPolicy(boost.Spec.DurationPolicy)
+	b.durationPolicies = mapDurationPolicy(spec.DurationPolicy)
+	b.overridePolicy = boost.Spec.Overrides.DeepCopy()
+	b.driftPolicy = boost.Spec.DriftPolicy
+	b.generation = boost.Generation
+	b.profileErr = profileErr
+	b.seedStats(boost.Status)
 	return nil
 }
 
-// resourcePolicy returns the resource policy for a given container
-func (b *StartupCPUBoostImpl) resourcePolicy(ctx context.Context, container *corev1.Container) (resource.ContainerPolicy, bool) {
-	b.RLock()
-	defer b.RUnlock()
-	for _, entry := range b.resourcePolicies {
+// resourcePolicy returns the resource policy for a given container of a given POD,
+// i.e. the first policy from given entries with both POD and container matching
+// rules matching
+func resourcePolicy(ctx context.Context, entries []containerPolicyEntry, pod *corev1.Pod,
+	container *corev1.Container) (resource.ContainerPolicy, bool) {
+	for _, entry := range entries {
+		if entry.podMatcher != nil && !entry.podMatcher.Matches(ctx, pod) {
+			continue
+		}
 		if entry.matcher != nil && entry.matcher.Matches(ctx, container) {
 			return entry.policy, true
 		}
@@ -362,6 +741,9 @@ func (b *StartupCPUBoostImpl) upsertPod(ctx context.Context, pod *corev1.Pod) er
 	defer b.Unlock()
 	log := b.loggerFromContext(ctx).WithValues("pod", pod.Name)
 	log.V(5).Info("handling pod upsert")
+	if annotation, err := bpod.BoostAnnotationFromPod(pod); err == nil && !annotation.HasInitCPUResources() {
+		return b.accountSkippedPod(ctx, pod, annotation)
+	}
 	_, existing := b.pods[pod.Name]
 	b.pods[pod.Name] = pod
 	statsEvent := StartupCPUBoostStatsEvent{StartupCPUBoostStatsPodCreateEvent, pod}
@@ -369,7 +751,18 @@ func (b *StartupCPUBoostImpl) upsertPod(ctx context.Context, pod *corev1.Pod) er
 		statsEvent.Type = StartupCPUBoostStatsPodUpdateEvent
 	}
 	b.updateStats(statsEvent)
+	if !existing {
+		b.recordBoostedEvents(pod)
+		b.trackDurationOverride(pod)
+	}
+	b.observePodStartup(pod, existing)
 	log.V(5).Info("pod upserted successfully")
+	if err := b.syncResizeState(ctx, pod); err != nil {
+		return fmt.Errorf("pod resize state update failed: %s", err)
+	}
+	if b.revertPending[pod.Name] {
+		return b.finalizeRevert(ctx, pod)
+	}
 	condPolicy, ok := b.durationPolicies[duration.PodConditionPolicyName]
 	if !ok {
 		log.V(5).Info("pod duration policy not found, skipping resource reversion")
@@ -391,11 +784,53 @@ func (b *StartupCPUBoostImpl) deletePod(ctx context.Context, pod *corev1.Pod) er
 	defer b.Unlock()
 	log := b.loggerFromContext(ctx).WithValues("pod", pod.Name)
 	log.V(5).Info("handling pod delete")
-	delete(b.pods, pod.Name)
+	delete(b.skippedPods, pod.UID)
+	b.untrackPod(pod.Name)
 	b.updateStats(StartupCPUBoostStatsEvent{StartupCPUBoostStatsPodDeleteEvent, pod})
 	return nil
 }
 
+// accountSkippedPod accounts the containers of a POD that were all skipped by the boost
+// in the usage statistics and records the events once the POD is created, so the
+// dry-run admission requests and rejected PODs are not accounted. The POD is not
+// tracked, as its resources were not boosted, and its boost label is removed once
+// accounted, so its events are no longer received.
+func (b *StartupCPUBoostImpl) accountSkippedPod(ctx context.Context, pod *corev1.Pod,
+	annotation *bpod.BoostPodAnnotation) error {
+	// PODs admitted before the seed are already counted in the seeded stats
+	if !b.skippedPods[pod.UID] && !annotation.BoostTimestamp.Before(b.skippedSeedTime) {
+		b.loggerFromContext(ctx).V(5).Info("accounting skipped pod", "pod", pod.Name)
+		for _, name := range slices.Sorted(maps.Keys(annotation.SkippedContainers)) {
+			reason := annotation.SkippedContainers[name]
+			b.stats.SkippedContainerBoosts[reason]++
+			metrics.AddSkippedContainers(b.namespace, b.name, reason, 1)
+			b.recordEvent(pod, corev1.EventTypeNormal, reason, EventActionBoost,
+				"Container %s was not boosted: %s", name, skippedContainerReasons[reason])
+		}
+	}
+	// the POD is remembered until its label is removed, so it is not accounted
+	// again when the removal fails
+	b.skippedPods[pod.UID] = true
+	if err := b.removeBoostLabel(ctx, pod); err != nil {
+		return fmt.Errorf("failed to remove boost label: %w", err)
+	}
+	delete(b.skippedPods, pod.UID)
+	return nil
+}
+
+// untrackPod removes the POD with a given name from the startup-cpu-boost tracking
+// and accounts the extra CPU core-seconds granted to the POD
+func (b *StartupCPUBoostImpl) untrackPod(name string) {
+	if pod, ok := b.pods[name]; ok {
+		b.accountExtraCPUCoreSeconds(pod)
+	}
+	delete(b.pods, name)
+	delete(b.revertPending, name)
+	delete(b.revertErrors, name)
+	delete(b.startupObserved, name)
+	delete(b.durationOverrides, name)
+}
+
 // loggerFromContext provides Logger from a current context with configured
 // values common for startup-cpu-boost like name or namespace
 func (b *StartupCPUBoostImpl) loggerFromContext(ctx context.Context) logr.Logger {
@@ -419,38 +854,268 @@ func (b *StartupCPUBoostImpl) validatePolicyOnPod(ctx context.Context, p duratio
 
 // revertResources updates POD's container resource requests and limits to their original
 // values using the data from StartupCPUBoost annotation
-func (b *StartupCPUBoostImpl) revertResources(ctx context.Context, pod *corev1.Pod) error {
-	var err error
-	log := b.loggerFromContext(ctx)
+func (b *StartupCPUBoostImpl) revertResources(ctx context.Context, pod *corev1.Pod) (err error) {
+	defer func() {
+		if err != nil {
+			b.revertErrors[pod.Name] = err.Error()
+			b.stats.LastRevertError = err.Error()
+			metrics.IncRevertFailures(b.namespace, b.name)
+			b.recordEvent(pod, corev1.EventTypeWarning, EventReasonRevertFailed, EventActionRevert,
+				"Failed to revert CPU resources: %s", err)
+		} else {
+			delete(b.revertErrors, pod.Name)
+		}
+		b.stats.FailedReverts = len(b.revertErrors)
+	}()
+	log := b.loggerFromContext(ctx).WithValues("pod", pod.Name)
+	drifted, err := resourcesDrifted(pod)
+	if err != nil {
+		return err
+	}
+	if drifted {
+		if b.driftPolicy != autoscaling.DriftPolicyDelta {
+			return b.skipRevert(ctx, pod)
+		}
+		log.Info("pod resources were changed during the boost, reverting the boost increase only")
+	}
 	if b.legacyRevertMode {
 		log.V(5).Info("reverting pod resources with legacy update method")
-		err = b.updateBoostPodLegacy(ctx, pod)
-	} else {
-		log.V(5).Info("reverting pod resources with new update method")
-		err = b.updateBoostPod(ctx, pod)
+		annotation, err := bpod.BoostAnnotationFromPod(pod)
+		if err != nil {
+			return err
+		}
+		if err := b.updateBoostPodLegacy(ctx, pod, drifted); err != nil {
+			return err
+		}
+		b.observeRevert(pod, annotation.BoostTimestamp)
+		b.untrackPod(pod.Name)
+		b.updateStats(StartupCPUBoostStatsEvent{StartupCPUBoostStatsPodDeleteEvent, pod})
+		b.recordEvent(pod, corev1.EventTypeNormal, EventReasonReverted, EventActionRevert,
+			"CPU resources were reverted")
+		return nil
+	}
+	log.V(5).Info("reverting pod resources with server-side apply")
+	err = b.applyRevertResources(ctx, pod, drifted, false)
+	if apierrors.IsConflict(err) {
+		livePod := pod
+		if apierrors.HasStatusCause(err, metav1.CauseTypeFieldManagerConflict) {
+			log.V(5).Info("pod CPU resources are managed by other field managers, verifying drift again")
+		} else {
+			log.V(5).Info("pod was changed since the drift verification, verifying drift again")
+			livePod = &corev1.Pod{}
+			if err := b.client.Get(ctx, client.ObjectKeyFromObject(pod), livePod); err != nil {
+				return err
+			}
+		}
+		if drifted, err = resourcesDrifted(livePod); err != nil {
+			return err
+		}
+		if drifted && b.driftPolicy != autoscaling.DriftPolicyDelta {
+			return b.skipRevert(ctx, livePod)
+		}
+		// the remaining owner of the CPU fields is the POD creator which values were boosted
+		// on admission, or the changes of others are preserved by the Delta drift policy
+		err = b.applyRevertResources(ctx, livePod, drifted, true)
+	}
+	if err != nil {
+		return err
 	}
+	// the POD in the cache still holds the boosted resources, the revert is finalized
+	// on the POD update event once the reverted resources are actuated by the kubelet
+	b.revertPending[pod.Name] = true
+	return nil
+}
+
+// applyRevertResources reverts POD's container CPU resources with server-side apply on the
+// resize sub-resource. The apply is bound to the POD's resource version that was verified
+// against drift. The ownership of the CPU fields is taken over only when force is set, so
+// the conflict with other field managers is returned otherwise.
+func (b *StartupCPUBoostImpl) applyRevertResources(ctx context.Context, pod *corev1.Pod, delta, force bool) error {
+	applyConfig, err := bpod.RevertBoostResourcesApplyConfiguration(pod, delta)
 	if err != nil {
 		return err
 	}
-	delete(b.pods, pod.Name)
+	opts := []client.SubResourceApplyOption{client.FieldOwner(FieldManagerName)}
+	if force {
+		opts = append(opts, client.ForceOwnership)
+	}
+	return b.client.SubResource(ResizeSubResourceName).Apply(ctx, applyConfig, opts...)
+}
+
+// resourcesDrifted determines if the POD's CPU resources were changed by other actors
+// during the boost, i.e. the POD was resized by another field manager or its CPU
+// resources differ from the boosted values
+func resourcesDrifted(pod *corev1.Pod) (bool, error) {
+	if bpod.ResizedByOthers(pod, FieldManagerName) {
+		return true, nil
+	}
+	return bpod.CPUResourcesDrifted(pod)
+}
+
+// skipRevert stops tracking the POD which CPU resources were changed by other actors
+// during the boost, leaving its resources unchanged
+func (b *StartupCPUBoostImpl) skipRevert(ctx context.Context, pod *corev1.Pod) error {
+	log := b.loggerFromContext(ctx).WithValues("pod", pod.Name)
+	log.Info("pod resources were changed during the boost, skipping resources reversion")
+	if err := b.patchRevertBoostLabels(ctx, pod); err != nil {
+		return err
+	}
+	b.untrackPod(pod.Name)
 	b.updateStats(StartupCPUBoostStatsEvent{StartupCPUBoostStatsPodDeleteEvent, pod})
+	b.recordEvent(pod, corev1.EventTypeWarning, EventReasonRevertSkipped, EventActionRevert,
+		"CPU resources were changed during the boost by another actor, skipping reversion")
 	return nil
 }
 
-// updateBoostPod restores original POD annotations, labels and resource requirements by patching
-func (b *StartupCPUBoostImpl) updateBoostPod(ctx context.Context, pod *corev1.Pod) error {
-	if err := b.client.SubResource(ResizeSubResourceName).Patch(ctx, pod, bpod.NewRevertBootsResourcesPatch()); err != nil {
+// finalizeRevert restores original POD annotations and labels and stops tracking the POD
+// once the reverted resources are actuated by the kubelet. Until then, the POD remains
+// tracked so the outcome of the in-place resize can be observed.
+func (b *StartupCPUBoostImpl) finalizeRevert(ctx context.Context, pod *corev1.Pod) error {
+	log := b.loggerFromContext(ctx).WithValues("pod", pod.Name)
+	reverted, err := bpod.CPUResourcesReverted(pod)
+	if err != nil {
+		return err
+	}
+	if !reverted {
+		log.V(5).Info("pod resources reversion is not yet observed")
+		return nil
+	}
+	if !bpod.CPUResourcesActuated(pod) {
+		log.V(5).Info("pod resources reversion is not yet actuated")
+		return nil
+	}
+	annotation, err := bpod.BoostAnnotationFromPod(pod)
+	if err != nil {
+		return err
+	}
+	if err := b.patchRevertBoostLabels(ctx, pod); err != nil {
 		return err
 	}
-	if err := b.client.Patch(ctx, pod, bpod.NewRevertBoostLabelsPatch()); err != nil {
+	b.observeRevert(pod, annotation.BoostTimestamp)
+	b.untrackPod(pod.Name)
+	b.updateStats(StartupCPUBoostStatsEvent{StartupCPUBoostStatsPodDeleteEvent, pod})
+	b.recordEvent(pod, corev1.EventTypeNormal, EventReasonReverted, EventActionRevert,
+		"CPU resources were reverted")
+	return nil
+}
+
+// patchRevertBoostLabels removes the boost label and annotation from the POD. The merge
+// patch is used as the server-side apply can't remove the label and annotation that are
+// owned by the POD creator's field manager.
+func (b *StartupCPUBoostImpl) patchRevertBoostLabels(ctx context.Context, pod *corev1.Pod) error {
+	return b.client.Patch(ctx, pod, bpod.NewRevertBoostLabelsPatch(), client.FieldOwner(FieldManagerName))
+}
+
+// removeBoostLabel removes the boost label from the POD which is not tracked, so its
+// events are no longer received and it is not kept as orphaned when the boost is gone
+func (b *StartupCPUBoostImpl) removeBoostLabel(ctx context.Context, pod *corev1.Pod) error {
+	return b.client.Patch(ctx, pod, bpod.NewRemoveBoostLabelPatch(), client.FieldOwner(FieldManagerName))
+}
+
+// observeRevert records the boost duration and the revert lag metrics of a POD
+// which resources were successfully reverted. The revert lag is measured from
+// the earliest passed deadline of the boost duration policies.
+func (b *StartupCPUBoostImpl) observeRevert(pod *corev1.Pod, boostTime time.Time) {
+	now := time.Now()
+	if !boostTime.IsZero() {
+		metrics.ObserveBoostDuration(b.namespace, b.name, now.Sub(boostTime).Seconds())
+	}
+	var deadline time.Time
+	for _, policy := range b.durationPolicies {
+		if policyDeadline, ok := b.podDurationPolicy(policy, pod).Deadline(pod); ok && !policyDeadline.After(now) &&
+			(deadline.IsZero() || policyDeadline.Before(deadline)) {
+			deadline = policyDeadline
+		}
+	}
+	if !deadline.IsZero() {
+		metrics.ObserveRevertLag(b.namespace, b.name, now.Sub(deadline).Seconds())
+	}
+}
+
+// accountExtraCPUCoreSeconds adds the extra CPU core-seconds granted to a POD from
+// the boost time until now to the usage statistics
+func (b *StartupCPUBoostImpl) accountExtraCPUCoreSeconds(pod *corev1.Pod) {
+	annot, err := bpod.BoostAnnotationFromPod(pod)
+	if err != nil || annot.BoostTimestamp.IsZero() {
+		return
+	}
+	extraCPU, err := annot.ExtraCPURequests()
+	if err != nil {
+		return
+	}
+	coreSeconds := extraCPU.AsApproximateFloat64() * time.Since(annot.BoostTimestamp).Seconds()
+	if coreSeconds <= 0 {
+		return
+	}
+	b.stats.ExtraCPUCoreSeconds += coreSeconds
+	metrics.AddBoostExtraCPUCoreSeconds(b.namespace, b.name, coreSeconds)
+}
+
+// observePodStartup records the startup latency metric of a POD once it becomes ready.
+// PODs that were ready before they were tracked, i.e. prior to the controller restart,
+// are not recorded.
+func (b *StartupCPUBoostImpl) observePodStartup(pod *corev1.Pod, existing bool) {
+	if b.startupObserved[pod.Name] {
+		return
+	}
+	readyTime, ok := podConditionTime(pod, corev1.PodReady)
+	if !ok {
+		return
+	}
+	b.startupObserved[pod.Name] = true
+	if scheduledTime, ok := podConditionTime(pod, corev1.PodScheduled); ok && existing {
+		metrics.ObservePodStartupLatency(b.namespace, b.name, readyTime.Sub(scheduledTime).Seconds())
+	}
+}
+
+// syncResizeState records the outcome of POD's in-place resize reported by the kubelet
+// in the POD's boost annotation state.
+func (b *StartupCPUBoostImpl) syncResizeState(ctx context.Context, pod *corev1.Pod) error {
+	if _, ok := pod.Annotations[bpod.BoostAnnotationKey]; !ok {
+		return nil
+	}
+	annotation, err := bpod.BoostAnnotationFromPod(pod)
+	if err != nil {
+		return fmt.Errorf("failed to parse boost annotation: %w", err)
+	}
+	state, reason := annotation.State, ""
+	status, message := bpod.PodResizeStatus(pod)
+	switch status {
+	case bpod.ResizeStatusDeferred:
+		state, reason = bpod.BoostStateDeferred, bpod.BoostReasonResizeDeferred
+	case bpod.ResizeStatusInfeasible:
+		state, reason = bpod.BoostStateInfeasible, bpod.BoostReasonResizeInfeasible
+	default:
+		if state == bpod.BoostStateDeferred || state == bpod.BoostStateInfeasible {
+			state, reason = bpod.BoostStateActive, bpod.BoostReasonResizeResumed
+		}
+	}
+	if state == annotation.State {
+		return nil
+	}
+	log := b.loggerFromContext(ctx).WithValues("pod", pod.Name, "state", state)
+	log.Info("pod resize state changed", "message", message)
+	if err := b.client.Patch(ctx, pod, bpod.NewBoostStatePatch(state, reason, message),
+		client.FieldOwner(FieldManagerName)); err != nil {
 		return err
 	}
+	// the outcome is counted once per POD, so the resize flipping between the
+	// states is not counted again
+	if state != bpod.BoostStateActive && !annotation.HasTransition(state) {
+		metrics.AddBoostResizeOutcome(b.namespace, b.name, state, float64(len(annotation.InitCPURequests)))
+	}
+	b.updateStats(StartupCPUBoostStatsEvent{StartupCPUBoostStatsPodUpdateEvent, pod})
 	return nil
 }
 
-// updateBoostPodLegacy restores original POD annotations, labels and resource requirements by updating
-func (b *StartupCPUBoostImpl) updateBoostPodLegacy(ctx context.Context, pod *corev1.Pod) error {
-	if err := bpod.RevertResourceBoost(pod); err != nil {
+// updateBoostPodLegacy restores original POD annotations, labels and resource requirements by updating.
+// When drifted is set, only the boost increase of the resources is reverted.
+func (b *StartupCPUBoostImpl) updateBoostPodLegacy(ctx context.Context, pod *corev1.Pod, drifted bool) error {
+	revertFunc := bpod.RevertResourceBoost
+	if drifted {
+		revertFunc = bpod.RevertResourceBoostDelta
+	}
+	if err := revertFunc(pod); err != nil {
 		return fmt.Errorf("failed to update pod spec: %s", err)
 	}
 	return b.client.Update(ctx, pod)
@@ -459,19 +1124,105 @@ func (b *StartupCPUBoostImpl) updateBoostPodLegacy(ctx context.Context, pod *cor
 // updateStats updates the StartupCPUBoost usage statistics based on the
 // received update event
 func (b *StartupCPUBoostImpl) updateStats(e StartupCPUBoostStatsEvent) {
-	var activeCnt int
+	var activeCnt, deferredCnt, infeasibleCnt int
+	var extraCPU apiResource.Quantity
 	for _, pod := range b.pods {
-		activeCnt += boostContainersLen(pod)
+		annot, err := bpod.BoostAnnotationFromPod(pod)
+		if err != nil {
+			continue
+		}
+		if podExtraCPU, err := annot.ExtraCPURequests(); err == nil {
+			extraCPU.Add(podExtraCPU)
+		}
+		cnt := len(annot.InitCPURequests)
+		activeCnt += cnt
+		switch annot.State {
+		case bpod.BoostStateDeferred:
+			deferredCnt += cnt
+		case bpod.BoostStateInfeasible:
+			infeasibleCnt += cnt
+		}
 	}
 	b.stats.ActiveContainerBoosts = activeCnt
+	b.stats.DeferredContainerBoosts = deferredCnt
+	b.stats.InfeasibleContainerBoosts = infeasibleCnt
+	b.stats.FailedReverts = len(b.revertErrors)
+	b.stats.ExtraCPU = extraCPU
 	metrics.SetBoostContainersActive(b.namespace, b.name, float64(activeCnt))
+	metrics.SetBoostExtraCPU(b.namespace, b.name, extraCPU.AsApproximateFloat64())
 	switch e.Type {
 	case StartupCPUBoostStatsPodCreateEvent:
-		pod := e.Object.(*corev1.Pod)
-		boostContainersLen := boostContainersLen(pod)
-		b.stats.TotalContainerBoosts += boostContainersLen
-		metrics.AddBoostContainersTotal(b.namespace, b.name, float64(boostContainersLen))
+		annot, err := bpod.BoostAnnotationFromPod(e.Object.(*corev1.Pod))
+		if err != nil {
+			return
+		}
+		// PODs boosted before the seed are already counted in the seeded stats
+		if !annot.BoostTimestamp.Before(b.totalSeedTime) {
+			boostContainersLen := len(annot.InitCPURequests)
+			b.stats.TotalContainerBoosts += boostContainersLen
+			metrics.AddBoostContainersTotal(b.namespace, b.name, float64(boostContainersLen))
+		}
+		if !annot.BoostTimestamp.Before(b.skippedSeedTime) {
+			for _, reason := range annot.SkippedContainers {
+				b.stats.SkippedContainerBoosts[reason]++
+				metrics.AddSkippedContainers(b.namespace, b.name, reason, 1)
+			}
+		}
+	}
+}
+
+// seedStats seeds the usage statistics from the StartupCPUBoost status, i.e. the one
+// persisted before the controller restart or by the previous leader. The statistics
+// never decrease and the PODs boosted before the seed are considered as already counted.
+// The total, skipped and dry-run container boosts are seeded independently, each with
+// its own seed time.
+func (b *StartupCPUBoostImpl) seedStats(status autoscaling.StartupCPUBoostStatus) {
+	now := time.Now()
+	if total := int(status.TotalContainerBoosts); total > b.stats.TotalContainerBoosts {
+		b.stats.TotalContainerBoosts = total
+		b.totalSeedTime = now
+	}
+	for _, skipped := range status.SkippedContainerBoosts {
+		if count := int(skipped.Count); count > b.stats.SkippedContainerBoosts[skipped.Reason] {
+			b.stats.SkippedContainerBoosts[skipped.Reason] = count
+			b.skippedSeedTime = now
+		}
 	}
+	b.stats.ExtraCPUCoreSeconds = max(b.stats.ExtraCPUCoreSeconds, float64(status.ExtraCPUCoreSeconds))
+	if dryRunTotal := int(status.DryRunContainerBoosts); dryRunTotal > b.stats.DryRunContainerBoosts {
+		b.stats.DryRunContainerBoosts = dryRunTotal
+		b.dryRunSeedTime = now
+	}
+	if status.DryRunExtraCPU != nil && status.DryRunExtraCPU.Cmp(b.stats.DryRunExtraCPU) > 0 {
+		b.stats.DryRunExtraCPU = status.DryRunExtraCPU.DeepCopy()
+	}
+}
+
+// activePodsStats returns the boost details of the tracked PODs ordered by
+// the boost time
+func (b *StartupCPUBoostImpl) activePodsStats() []PodBoostStats {
+	fixedPolicy, hasFixedPolicy := b.durationPolicies[duration.FixedDurationPolicyName]
+	result := make([]PodBoostStats, 0, len(b.pods))
+	for _, pod := range b.pods {
+		annot, err := bpod.BoostAnnotationFromPod(pod)
+		if err != nil {
+			continue
+		}
+		podStats := PodBoostStats{Name: pod.Name, BoostTime: annot.BoostTimestamp}
+		if hasFixedPolicy {
+			if revertTime, ok := b.podDurationPolicy(fixedPolicy, pod).Deadline(pod); ok {
+				podStats.ExpectedRevertTime = &revertTime
+			}
+		}
+		result = append(result, podStats)
+	}
+	slices.SortFunc(result, func(a, b PodBoostStats) int {
+		if c := a.BoostTime.Compare(b.BoostTime); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result
 }
 
 func (b *StartupCPUBoostImpl) canRemoveLimit(qosClass corev1.PodQOSClass, log logr.Logger) bool {
@@ -485,13 +1236,28 @@ func (b *StartupCPUBoostImpl) canRemoveLimit(qosClass corev1.PodQOSClass, log lo
 	return true
 }
 
-// boostContainersLen returns the number of containers that were boosted
-// by StartupCPUBoost in a given Pod
-func boostContainersLen(pod *corev1.Pod) (cnt int) {
-	if annot, err := bpod.BoostAnnotationFromPod(pod); err == nil {
-		return len(annot.InitCPURequests)
+// podConditionTime returns the last transition time of a given POD condition
+// if the condition is true
+func podConditionTime(pod *corev1.Pod, conditionType corev1.PodConditionType) (time.Time, bool) {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return condition.LastTransitionTime.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// boostReference returns StartupCPUBoost object holding only the metadata needed
+// to reference it, i.e. in the events
+func boostReference(boost *autoscaling.StartupCPUBoost) *autoscaling.StartupCPUBoost {
+	return &autoscaling.StartupCPUBoost{
+		TypeMeta: boost.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      boost.Name,
+			Namespace: boost.Namespace,
+			UID:       boost.UID,
+		},
 	}
-	return
 }
 
 // mapDurationPolicy maps the Duration Policy from the API spec to the map of policy
@@ -508,43 +1274,100 @@ func mapDurationPolicy(policiesSpec autoscaling.DurationPolicy) map[string]durat
 	return policies
 }
 
-func containerPolicyMatcher(policySpec autoscaling.ContainerPolicy) resource.ContainerMatcher {
-	//lint:ignore SA1019 backwards-compatible support for deprecated ContainerName
-	if name := policySpec.ContainerName; name != "" {
-		return resource.FixedNameContainerMatcher{
-			Name: name,
-		}
+// mapMatchContainersPolicy maps the container matching rules from the API spec to the
+// container matcher implementation. The Composite rule is mapped to a tree of container
+// matchers. The regular expressions are compiled once here.
+func mapMatchContainersPolicy(policySpec *autoscaling.MatchContainers) (resource.ContainerMatcher, error) {
+	if policySpec.Type != autoscaling.MatchContainersTypeComposite {
+		return mapMatchContainersRule(autoscaling.MatchContainersRule{
+			Type:   policySpec.Type,
+			Value:  policySpec.Value,
+			Tag:    policySpec.Tag,
+			Digest: policySpec.Digest,
+		})
 	}
-	if policySpec.MatchContainers != nil {
-		return mapMatchContainersPolicy(policySpec.MatchContainers)
+	var errs []error
+	include, err := mapMatchContainersRules(policySpec.Include)
+	errs = append(errs, err)
+	exclude, err := mapMatchContainersRules(policySpec.Exclude)
+	errs = append(errs, err)
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
 	}
-	return nil
+	return resource.CompositeContainerMatcher{
+		Include: include,
+		Exclude: exclude,
+	}, nil
 }
 
-func mapMatchContainersPolicy(policySpec *autoscaling.MatchContainers) resource.ContainerMatcher {
-	switch policySpec.Type {
+func mapMatchContainersRules(rulesSpec []autoscaling.MatchContainersRule) ([]resource.ContainerMatcher, error) {
+	var errs []error
+	matchers := make([]resource.ContainerMatcher, 0, len(rulesSpec))
+	for _, ruleSpec := range rulesSpec {
+		matcher, err := mapMatchContainersRule(ruleSpec)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		matchers = append(matchers, matcher)
+	}
+	return matchers, errors.Join(errs...)
+}
+
+func mapMatchContainersRule(ruleSpec autoscaling.MatchContainersRule) (resource.ContainerMatcher, error) {
+	switch ruleSpec.Type {
 	case autoscaling.MatchContainersTypeExactName:
 		return resource.FixedNameContainerMatcher{
-			Name: policySpec.Value,
-		}
+			Name: ruleSpec.Value,
+		}, nil
 	case autoscaling.MatchContainersTypeRegexName:
-		return resource.RegexNameContainerMatcher{
-			Expr: policySpec.Value,
-		}
+		return resource.NewRegexNameContainerMatcher(ruleSpec.Value)
+	case autoscaling.MatchContainersTypeImage:
+		return resource.NewImageContainerMatcher(ruleSpec.Value, ruleSpec.Tag, ruleSpec.Digest)
 	default:
-		return nil
+		return nil, fmt.Errorf("unsupported container matcher type %q", ruleSpec.Type)
+	}
+}
+
+// mapMatchPodPolicy maps the POD matching rules from the API spec to the POD matcher
+// implementation. The matcher is nil when there are no POD matching rules.
+func mapMatchPodPolicy(policySpec *autoscaling.MatchPod) (resource.PodMatcher, error) {
+	if policySpec == nil {
+		return nil, nil
+	}
+	matcher := resource.PodAttributesMatcher{
+		PriorityClassNames: policySpec.PriorityClassNames,
+		OwnerKinds:         policySpec.OwnerKinds,
+		NodeSelectorKeys:   policySpec.NodeSelectorKeys,
+	}
+	if policySpec.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(policySpec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid POD label selector: %w", err)
+		}
+		matcher.Selector = selector
 	}
+	return matcher, nil
 }
 
 // mapResourcePolicies maps the Resource Policy from the API spec to a slice of container policy entries
 func mapResourcePolicies(spec autoscaling.ResourcePolicy) ([]containerPolicyEntry, error) {
 	var errs []error
 	var entries []containerPolicyEntry
-	for _, policySpec := range spec.ContainerPolicies {
-		matcher := containerPolicyMatcher(policySpec)
-		if matcher == nil {
+	for i, policySpec := range spec.ContainerPolicies {
+		if policySpec.MatchContainers == nil {
 			errs = append(errs,
-				fmt.Errorf("container policy must specify either containerName or matchContainers"))
+				fmt.Errorf("container policy must specify matchContainers"))
+			continue
+		}
+		matcher, err := mapMatchContainersPolicy(policySpec.MatchContainers)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		podMatcher, err := mapMatchPodPolicy(policySpec.MatchPod)
+		if err != nil {
+			errs = append(errs, err)
 			continue
 		}
 
@@ -559,20 +1382,15 @@ func mapResourcePolicies(spec autoscaling.ResourcePolicy) ([]containerPolicyEntr
 			cnt++
 		}
 		if cnt != 1 {
-			//lint:ignore SA1019 backwards-compatible support for deprecated ContainerName
-			name := policySpec.ContainerName
-			if name == "" && policySpec.MatchContainers != nil {
-				name = policySpec.MatchContainers.Value
-			}
 			errs = append(
 				errs,
-				fmt.Errorf("invalid number of resource policies for container %s; must be one",
-					name))
+				fmt.Errorf("invalid number of resource policies for container policy %d; must be one", i))
 			continue
 		}
 		entries = append(entries, containerPolicyEntry{
-			matcher: matcher,
-			policy:  policy,
+			matcher:    matcher,
+			podMatcher: podMatcher,
+			policy:     policy,
 		})
 	}
 	if len(errs) > 0 {
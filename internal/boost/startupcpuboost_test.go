@@ -16,20 +16,30 @@ package boost_test
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
-	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	cpuboost "github.com/google/kube-startup-cpu-boost/internal/boost"
 	"github.com/google/kube-startup-cpu-boost/internal/boost/duration"
 	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	"github.com/google/kube-startup-cpu-boost/internal/boost/resource"
 	"github.com/google/kube-startup-cpu-boost/internal/metrics"
 	"github.com/google/kube-startup-cpu-boost/internal/mock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("StartupCPUBoost", func() {
@@ -54,7 +64,7 @@ var _ = Describe("StartupCPUBoost", func() {
 	})
 	Describe("Instantiates from the API specification", func() {
 		JustBeforeEach(func() {
-			boost, err = cpuboost.NewStartupCPUBoost(spec, config)
+			boost, err = cpuboost.NewStartupCPUBoost(context.Background(), spec, config)
 		})
 		It("does not error", func() {
 			Expect(err).NotTo(HaveOccurred())
@@ -68,35 +78,10 @@ var _ = Describe("StartupCPUBoost", func() {
 		When("the spec has resource policy for containers", func() {
 			var (
 				containerOneName            = "container-one"
-				containerTwoName            = "container-two"
 				containerOnePercValue int64 = 120
 				containerTwoFixedReq        = apiResource.MustParse("1")
 				containerTwoFixedLim        = apiResource.MustParse("2")
 			)
-			Context("with deprecated container name policy", func() {
-				BeforeEach(func() {
-					spec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
-						ContainerPolicies: []autoscaling.ContainerPolicy{
-							{
-								ContainerName: containerOneName,
-								PercentageIncrease: &autoscaling.PercentageIncrease{
-									Value: containerOnePercValue,
-								},
-							},
-							{
-								ContainerName: containerTwoName,
-								FixedResources: &autoscaling.FixedResources{
-									Requests: containerTwoFixedReq,
-									Limits:   containerTwoFixedLim,
-								},
-							},
-						},
-					}
-				})
-				It("does not error", func() {
-					Expect(err).NotTo(HaveOccurred())
-				})
-			})
 			Context("with match containers policy", func() {
 				BeforeEach(func() {
 					spec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
@@ -120,6 +105,16 @@ var _ = Describe("StartupCPUBoost", func() {
 									Limits:   containerTwoFixedLim,
 								},
 							},
+							{
+								MatchContainers: &autoscaling.MatchContainers{
+									Type:  autoscaling.MatchContainersTypeImage,
+									Value: "^eclipse-temurin$",
+									Tag:   "21-jre",
+								},
+								PercentageIncrease: &autoscaling.PercentageIncrease{
+									Value: containerOnePercValue,
+								},
+							},
 						},
 					}
 				})
@@ -128,6 +123,24 @@ var _ = Describe("StartupCPUBoost", func() {
 				})
 			})
 		})
+		When("the spec has container policy with invalid regular expression", func() {
+			BeforeEach(func() {
+				spec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
+					ContainerPolicies: []autoscaling.ContainerPolicy{
+						{
+							MatchContainers: &autoscaling.MatchContainers{
+								Type:  autoscaling.MatchContainersTypeImage,
+								Value: "^eclipse-temurin[$",
+							},
+							PercentageIncrease: &autoscaling.PercentageIncrease{Value: 50},
+						},
+					},
+				}
+			})
+			It("errors", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
 		When("the spec has container policy without resource policy", func() {
 			BeforeEach(func() {
 				spec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
@@ -203,12 +216,47 @@ var _ = Describe("StartupCPUBoost", func() {
 			})
 		})
 	})
+	Describe("Matches PODs by target reference", func() {
+		BeforeEach(func() {
+			spec.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "demo",
+			}
+		})
+		When("owner resolver is not configured", func() {
+			It("errors", func() {
+				_, err := cpuboost.NewStartupCPUBoost(context.Background(), spec, config)
+				Expect(err).To(MatchError(cpuboost.ErrNilOwnerResolver))
+			})
+		})
+		When("owner resolver is configured", func() {
+			BeforeEach(func() {
+				config.OwnerResolver = cpuboost.NewOwnerResolver(mockClient)
+				boost, err = cpuboost.NewStartupCPUBoost(context.Background(), spec, config)
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("returns the target reference", func() {
+				Expect(boost.TargetRef()).To(Equal(spec.Spec.TargetRef))
+			})
+			It("matches POD controlled by the target", func() {
+				controller := true
+				pod.OwnerReferences = []metav1.OwnerReference{
+					{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "demo", Controller: &controller},
+				}
+				Expect(boost.Matches(context.TODO(), pod)).To(BeTrue())
+			})
+			It("does not match POD without controller", func() {
+				Expect(boost.Matches(context.TODO(), pod)).To(BeFalse())
+			})
+		})
+	})
 	Describe("Handles POD upsert triggering events", func() {
 		Context("when boost spec has no condition policy defined", func() {
 			When("POD does not exist", func() {
 				DescribeTable("adds POD, updates stats and metrics",
 					func(ctx context.Context, eventType bpod.PodEventType) {
-						boost, err := cpuboost.NewStartupCPUBoost(spec, config)
+						boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 						Expect(err).NotTo(HaveOccurred())
 
 						err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
@@ -232,7 +280,7 @@ var _ = Describe("StartupCPUBoost", func() {
 			When("POD already exists", func() {
 				DescribeTable("updates POD, stats and metrics",
 					func(ctx context.Context, eventType bpod.PodEventType) {
-						boost, err := cpuboost.NewStartupCPUBoost(spec, config)
+						boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 						Expect(err).NotTo(HaveOccurred())
 						err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
 							Type: bpod.PodEventTypePodCreated,
@@ -289,7 +337,7 @@ var _ = Describe("StartupCPUBoost", func() {
 							mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(),
 								gomock.Any()).Return(nil).Times(0)
 							config.Client = mockClient
-							boost, err = cpuboost.NewStartupCPUBoost(spec, config)
+							boost, err = cpuboost.NewStartupCPUBoost(ctx, spec, config)
 							Expect(err).NotTo(HaveOccurred())
 
 							err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
@@ -311,18 +359,18 @@ var _ = Describe("StartupCPUBoost", func() {
 							func(ctx context.Context, eventType bpod.PodEventType) {
 								pod := podTemplate.DeepCopy()
 								pod.Status.Conditions = []corev1.PodCondition{{
-									Type:   corev1.PodReady,
-									Status: corev1.ConditionTrue,
+									Type:               corev1.PodReady,
+									Status:             corev1.ConditionTrue,
+									LastTransitionTime: metav1.NewTime(time.Now()),
 								}}
 								mockSubResourceClient := mock.NewMockSubResourceClient(mockCtrl)
 								mockClient := mock.NewMockClient(mockCtrl)
-								mockSubResourceClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
-									gomock.Eq(bpod.NewRevertBootsResourcesPatch())).Return(nil).Times(1)
+								mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, false)),
+									gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).Return(nil).Times(1)
 								mockClient.EXPECT().SubResource("resize").Return(mockSubResourceClient).Times(1)
-								mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
-									gomock.Eq(bpod.NewRevertBoostLabelsPatch())).Return(nil).Times(1)
+								mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 								config.Client = mockClient
-								boost, err = cpuboost.NewStartupCPUBoost(spec, config)
+								boost, err = cpuboost.NewStartupCPUBoost(ctx, spec, config)
 								Expect(err).NotTo(HaveOccurred())
 
 								err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
@@ -331,6 +379,9 @@ var _ = Describe("StartupCPUBoost", func() {
 								})
 
 								Expect(err).NotTo(HaveOccurred())
+								_, found := boost.Pod(pod.Name)
+								Expect(found).To(BeTrue())
+								Expect(metrics.BoostDurationCount(boost.Namespace(), boost.Name())).To(BeZero())
 							},
 							Entry("via PodCreatedEvent", bpod.PodEventTypePodCreated),
 							Entry("via ConditionChanged event", bpod.PodEventTypeConditionChanged),
@@ -350,7 +401,7 @@ var _ = Describe("StartupCPUBoost", func() {
 									Return(nil)
 								config.Client = mockClient
 								config.LegacyRevertMode = true
-								boost, err = cpuboost.NewStartupCPUBoost(spec, config)
+								boost, err = cpuboost.NewStartupCPUBoost(ctx, spec, config)
 								Expect(err).NotTo(HaveOccurred())
 
 								err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
@@ -359,6 +410,7 @@ var _ = Describe("StartupCPUBoost", func() {
 								})
 
 								Expect(err).NotTo(HaveOccurred())
+								Expect(metrics.BoostDurationCount(boost.Namespace(), boost.Name())).To(Equal(uint64(1)))
 							},
 							Entry("via PodCreatedEvent", bpod.PodEventTypePodCreated),
 							Entry("via ConditionChanged event", bpod.PodEventTypeConditionChanged),
@@ -368,159 +420,1469 @@ var _ = Describe("StartupCPUBoost", func() {
 			})
 		})
 	})
-	Describe("Handles POD deleted event", func() {
-		When("POD exists", func() {
-			It("removes POD, updates stats and metrics", func(ctx context.Context) {
-				boost, err := cpuboost.NewStartupCPUBoost(spec, config)
+	Describe("Handles POD in-place resize outcome", func() {
+		When("POD resize is infeasible", func() {
+			BeforeEach(func() {
+				pod.Status.Conditions = []corev1.PodCondition{{
+					Type:   corev1.PodResizePending,
+					Status: corev1.ConditionTrue,
+					Reason: corev1.PodReasonInfeasible,
+				}}
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
+					gomock.Eq(bpod.NewBoostStatePatch(bpod.BoostStateInfeasible,
+						bpod.BoostReasonResizeInfeasible, "")),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+					DoAndReturn(applyPatch).Times(1)
+			})
+			It("records the outcome in annotation, stats and metrics", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 				Expect(err).NotTo(HaveOccurred())
+
 				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
-					Type: bpod.PodEventTypePodCreated,
+					Type: bpod.PodEventTypeConditionChanged,
 					Pod:  pod,
 				})
+
+				Expect(err).NotTo(HaveOccurred())
+				annot, err := bpod.BoostAnnotationFromPod(pod)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(annot.State).To(Equal(bpod.BoostStateInfeasible))
+				stats := boost.Stats()
+				Expect(stats.ActiveContainerBoosts).To(Equal(2))
+				Expect(stats.InfeasibleContainerBoosts).To(Equal(2))
+				Expect(stats.DeferredContainerBoosts).To(Equal(0))
+				Expect(metrics.BoostResizeOutcomes(boost.Namespace(), boost.Name(),
+					bpod.BoostStateInfeasible)).To(Equal(float64(2)))
+			})
+		})
+		When("POD resize is deferred again", func() {
+			BeforeEach(func() {
+				annot, err := bpod.BoostAnnotationFromPod(pod)
+				Expect(err).NotTo(HaveOccurred())
+				annot.SetState(bpod.BoostStateDeferred, bpod.BoostReasonResizeDeferred, "")
+				annot.SetState(bpod.BoostStateActive, bpod.BoostReasonResizeResumed, "")
+				annot.Apply(pod)
+				pod.Status.Conditions = []corev1.PodCondition{{
+					Type:   corev1.PodResizePending,
+					Status: corev1.ConditionTrue,
+					Reason: corev1.PodReasonDeferred,
+				}}
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
+					gomock.Eq(bpod.NewBoostStatePatch(bpod.BoostStateDeferred,
+						bpod.BoostReasonResizeDeferred, "")),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+					DoAndReturn(applyPatch).Times(1)
+			})
+			It("does not count the outcome again", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 				Expect(err).NotTo(HaveOccurred())
 
 				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
-					Type: bpod.PodEventTypePodDeleted,
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boost.Stats().DeferredContainerBoosts).To(Equal(2))
+				Expect(metrics.BoostResizeOutcomes(boost.Namespace(), boost.Name(),
+					bpod.BoostStateDeferred)).To(BeZero())
+			})
+		})
+		When("POD boost annotation is malformed", func() {
+			BeforeEach(func() {
+				pod.Annotations[bpod.BoostAnnotationKey] = "{"
+			})
+			It("errors", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
 					Pod:  pod,
 				})
 
+				Expect(err).To(MatchError(ContainSubstring("failed to parse boost annotation")))
+			})
+		})
+		When("POD resources reversion is not yet actuated", func() {
+			var mockSubResourceClient *mock.MockSubResourceClient
+			BeforeEach(func() {
+				spec.Spec.DurationPolicy.PodCondition = &autoscaling.PodConditionDurationPolicy{
+					Type:   corev1.PodReady,
+					Status: corev1.ConditionTrue,
+				}
+				pod.Status.Conditions = []corev1.PodCondition{{
+					Type:   corev1.PodReady,
+					Status: corev1.ConditionTrue,
+				}}
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name:      "container-one",
+						Resources: pod.Spec.Containers[0].Resources.DeepCopy(),
+					},
+					{
+						Name:      "container-two",
+						Resources: pod.Spec.Containers[1].Resources.DeepCopy(),
+					},
+				}
+				mockSubResourceClient = mock.NewMockSubResourceClient(mockCtrl)
+				mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, false)),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).Return(nil).Times(1)
+				mockClient.EXPECT().SubResource("resize").Return(mockSubResourceClient).Times(1)
+			})
+			It("keeps tracking the POD until reverted resources are actuated", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  pod,
+				})
 				Expect(err).NotTo(HaveOccurred())
 				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeTrue())
+
+				revertedPod := pod.DeepCopy()
+				for i := range revertedPod.Spec.Containers {
+					setContainerResource(revertedPod, i, corev1.ResourceCPU, "500m", "1")
+				}
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeResourcesChanged,
+					Pod:  revertedPod,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				_, found = boost.Pod(pod.Name)
+				Expect(found).To(BeTrue())
+				Expect(metrics.BoostDurationCount(boost.Namespace(), boost.Name())).To(BeZero())
+
+				actuatedPod := revertedPod.DeepCopy()
+				actuatedPod.Status.ContainerStatuses[0].Resources = actuatedPod.Spec.Containers[0].Resources.DeepCopy()
+				actuatedPod.Status.ContainerStatuses[1].Resources = actuatedPod.Spec.Containers[1].Resources.DeepCopy()
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(actuatedPod),
+					gomock.Eq(bpod.NewRevertBoostLabelsPatch()),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).Return(nil).Times(1)
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeResourcesChanged,
+					Pod:  actuatedPod,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				_, found = boost.Pod(pod.Name)
 				Expect(found).To(BeFalse())
-				stats := boost.Stats()
-				Expect(stats.ActiveContainerBoosts).To(Equal(0))
-				Expect(stats.TotalContainerBoosts).To(Equal(2))
-				Expect(metrics.BoostContainersActive(boost.Namespace(), boost.Name())).To(Equal(float64(0)))
-				Expect(metrics.BoostContainersTotal(boost.Namespace(), boost.Name())).To(Equal(float64(2)))
+				Expect(boost.Stats().ActiveContainerBoosts).To(Equal(0))
+				Expect(metrics.BoostDurationCount(boost.Namespace(), boost.Name())).To(Equal(uint64(1)))
 			})
 		})
 	})
-	Describe("Updates boost from the spec", func() {
-		var (
-			updatedSpec *autoscaling.StartupCPUBoost
-		)
+	Describe("Handles POD resources drift on revert", func() {
+		var recorder *events.FakeRecorder
 		BeforeEach(func() {
-			spec.Selector = metav1.LabelSelector{
-				MatchLabels: map[string]string{
-					"app": "test",
-				},
-			}
-			spec.Spec.DurationPolicy.Fixed = &autoscaling.FixedDurationPolicy{
-				Unit:  autoscaling.FixedDurationPolicyUnitMin,
-				Value: 2,
-			}
+			recorder = events.NewFakeRecorder(10)
+			config.EventRecorder = recorder
 			spec.Spec.DurationPolicy.PodCondition = &autoscaling.PodConditionDurationPolicy{
+				Type:   corev1.PodReady,
 				Status: corev1.ConditionTrue,
+			}
+			pod.Status.Conditions = []corev1.PodCondition{{
 				Type:   corev1.PodReady,
+				Status: corev1.ConditionTrue,
+			}}
+			annot := &bpod.BoostPodAnnotation{
+				BoostTimestamp:     time.Now(),
+				InitCPURequests:    map[string]string{containerOneName: "500m"},
+				InitCPULimits:      map[string]string{containerOneName: "1"},
+				BoostedCPURequests: map[string]string{containerOneName: "1"},
+				BoostedCPULimits:   map[string]string{containerOneName: "2"},
 			}
-			updatedSpec = spec.DeepCopy()
-		})
-		JustBeforeEach(func() {
-			boost, err = cpuboost.NewStartupCPUBoost(spec, config)
-			Expect(err).ShouldNot(HaveOccurred())
-			err = boost.UpdateFromSpec(context.TODO(), updatedSpec)
+			annot.Apply(pod)
+			setContainerResource(pod, 0, corev1.ResourceCPU, "1500m", "2")
 		})
-		When("selector is changed", func() {
-			var (
-				podToSelect *corev1.Pod
-			)
+		When("drift policy is not set", func() {
 			BeforeEach(func() {
-				updatedSpec.Selector = metav1.LabelSelector{
-					MatchLabels: map[string]string{
-						"app": "newApp",
-					},
-				}
-				podToSelect = &corev1.Pod{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "test-pod",
-						Namespace: specTemplate.Namespace,
-						Labels: map[string]string{
-							"app": "newApp",
-						}}}
+				mockClient.EXPECT().SubResource(gomock.Any()).Times(0)
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
+					gomock.Eq(bpod.NewRevertBoostLabelsPatch()),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).Return(nil).Times(1)
 			})
-			It("matches pod with new selector", func() {
-				Expect(boost.Matches(podToSelect)).To(BeTrue())
+			It("skips resources reversion and records an event", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeFalse())
+				Expect(recordedEvents(recorder)).To(ContainElement(ContainSubstring(cpuboost.EventReasonRevertSkipped)))
 			})
 		})
-		When("duration policy is changed", func() {
-			var (
-				durationPolicies map[string]duration.Policy
-			)
+		When("drift policy is Delta", func() {
 			BeforeEach(func() {
-				updatedSpec.Spec.DurationPolicy.Fixed = &autoscaling.FixedDurationPolicy{
-					Unit:  autoscaling.FixedDurationPolicyUnitMin,
-					Value: 1000,
-				}
-				updatedSpec.Spec.DurationPolicy.PodCondition = &autoscaling.PodConditionDurationPolicy{
-					Type:   corev1.PodInitialized,
-					Status: corev1.ConditionTrue,
-				}
-			})
-			JustBeforeEach(func() {
-				durationPolicies = boost.DurationPolicies()
-			})
-			It("has valid fixed duration policy", func() {
-				durationPolicy := durationPolicies[duration.FixedDurationPolicyName]
-				Expect(durationPolicy).To(BeAssignableToTypeOf(&duration.FixedDurationPolicy{}))
-				fixedDurationPolicy := durationPolicy.(*duration.FixedDurationPolicy)
-				Expect(fixedDurationPolicy.Duration()).To(Equal(1000 * time.Minute))
+				spec.Spec.DriftPolicy = autoscaling.DriftPolicyDelta
+				mockSubResourceClient := mock.NewMockSubResourceClient(mockCtrl)
+				mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, true)),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).Return(nil).Times(1)
+				mockClient.EXPECT().SubResource("resize").Return(mockSubResourceClient).Times(1)
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 			})
-			It("has valid pod condition policy", func() {
-				durationPolicy := durationPolicies[duration.PodConditionPolicyName]
-				Expect(durationPolicy).To(BeAssignableToTypeOf(&duration.PodConditionPolicy{}))
-				podConditionDurationPolicy := durationPolicy.(*duration.PodConditionPolicy)
-				Expect(podConditionDurationPolicy.Condition()).To(Equal(corev1.PodInitialized))
-				Expect(podConditionDurationPolicy.Status()).To(Equal(corev1.ConditionTrue))
+			It("reverts the boost increase only", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeTrue())
+				Expect(recordedEvents(recorder)).NotTo(
+					ContainElement(ContainSubstring(cpuboost.EventReasonRevertSkipped)))
 			})
 		})
-		When("resource policy is changed", func() {
+	})
+	Describe("Handles server-side apply conflicts on revert", func() {
+		var (
+			livePod               *corev1.Pod
+			recorder              *events.FakeRecorder
+			mockSubResourceClient *mock.MockSubResourceClient
+		)
+		BeforeEach(func() {
+			recorder = events.NewFakeRecorder(10)
+			config.EventRecorder = recorder
+			spec.Spec.DurationPolicy.PodCondition = &autoscaling.PodConditionDurationPolicy{
+				Type:   corev1.PodReady,
+				Status: corev1.ConditionTrue,
+			}
+			pod.Status.Conditions = []corev1.PodCondition{{
+				Type:   corev1.PodReady,
+				Status: corev1.ConditionTrue,
+			}}
+			annot := &bpod.BoostPodAnnotation{
+				BoostTimestamp:     time.Now(),
+				InitCPURequests:    map[string]string{containerOneName: "500m"},
+				InitCPULimits:      map[string]string{containerOneName: "1"},
+				BoostedCPURequests: map[string]string{containerOneName: "1"},
+				BoostedCPULimits:   map[string]string{containerOneName: "2"},
+			}
+			annot.Apply(pod)
+			pod.ResourceVersion = "1"
+			livePod = pod.DeepCopy()
+			livePod.ResourceVersion = "2"
+			mockSubResourceClient = mock.NewMockSubResourceClient(mockCtrl)
+			mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, false)),
+				gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+				Return(apierrors.NewConflict(schema.GroupResource{Resource: "pods"}, pod.Name,
+					errors.New("the object has been modified"))).Times(1)
+			mockClient.EXPECT().SubResource("resize").Return(mockSubResourceClient).AnyTimes()
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(client.ObjectKeyFromObject(pod)), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj client.Object,
+					opts ...client.GetOption) error {
+					livePod.DeepCopyInto(obj.(*corev1.Pod))
+					return nil
+				}).Times(1)
+		})
+		When("live POD resources hold the boosted values", func() {
 			BeforeEach(func() {
-				updatedSpec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
-					ContainerPolicies: []autoscaling.ContainerPolicy{
-						{
-							MatchContainers: &autoscaling.MatchContainers{
-								Type:  autoscaling.MatchContainersTypeExactName,
-								Value: "test",
-							},
-							PercentageIncrease: &autoscaling.PercentageIncrease{
-								Value: 1000,
-							},
-						},
-					},
-				}
+				mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(livePod, false)),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName)), gomock.Eq(client.ForceOwnership)).
+					Return(nil).Times(1)
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			})
+			It("reverts resources of the live POD", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})
 
+				Expect(err).NotTo(HaveOccurred())
+				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeTrue())
+				Expect(recordedEvents(recorder)).NotTo(
+					ContainElement(ContainSubstring(cpuboost.EventReasonRevertSkipped)))
 			})
-			JustBeforeEach(func() {
-				err = boost.UpdateFromSpec(context.TODO(), updatedSpec)
+		})
+		When("live POD resources were changed by other actor", func() {
+			BeforeEach(func() {
+				setContainerResource(livePod, 0, corev1.ResourceCPU, "1500m", "2")
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(livePod),
+					gomock.Eq(bpod.NewRevertBoostLabelsPatch()),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).Return(nil).Times(1)
 			})
-			It("applies updated resource policy", func() {
+			It("skips resources reversion and records an event", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
 				Expect(err).NotTo(HaveOccurred())
 
-				pod := podTemplate.DeepCopy()
-				pod.Spec.Containers[0].Name = "test"
-				setContainerResource(pod, 0, corev1.ResourceCPU, "1", "2")
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})
 
-				err = boost.ApplyResourcePolicy(context.Background(), pod)
 				Expect(err).NotTo(HaveOccurred())
-
-				// 1 CPU * 1000% = 11 CPU (10 + 1) -> 11000m
-				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(11000)))
+				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeFalse())
+				Expect(recordedEvents(recorder)).To(ContainElement(ContainSubstring(cpuboost.EventReasonRevertSkipped)))
 			})
 		})
 	})
-	Describe("ApplyResourcePolicy", func() {
-		When("POD has no containers that match policy", func() {
-			It("Does not change POD", func() {
+	Describe("Handles field manager conflicts on revert", func() {
+		var (
+			recorder              *events.FakeRecorder
+			mockSubResourceClient *mock.MockSubResourceClient
+		)
+		BeforeEach(func() {
+			recorder = events.NewFakeRecorder(10)
+			config.EventRecorder = recorder
+			spec.Spec.DurationPolicy.PodCondition = &autoscaling.PodConditionDurationPolicy{
+				Type:   corev1.PodReady,
+				Status: corev1.ConditionTrue,
+			}
+			pod.Status.Conditions = []corev1.PodCondition{{
+				Type:   corev1.PodReady,
+				Status: corev1.ConditionTrue,
+			}}
+			annot := &bpod.BoostPodAnnotation{
+				BoostTimestamp:     time.Now(),
+				InitCPURequests:    map[string]string{containerOneName: "500m"},
+				InitCPULimits:      map[string]string{containerOneName: "1"},
+				BoostedCPURequests: map[string]string{containerOneName: "1"},
+				BoostedCPULimits:   map[string]string{containerOneName: "2"},
+			}
+			annot.Apply(pod)
+			pod.ResourceVersion = "1"
+			mockSubResourceClient = mock.NewMockSubResourceClient(mockCtrl)
+			mockClient.EXPECT().SubResource("resize").Return(mockSubResourceClient).AnyTimes()
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		})
+		When("CPU resources are managed by the POD creator only", func() {
+			BeforeEach(func() {
+				pod.ManagedFields = []metav1.ManagedFieldsEntry{
+					{Manager: "kubectl-create", Operation: metav1.ManagedFieldsOperationUpdate},
+				}
+				gomock.InOrder(
+					mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, false)),
+						gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+						Return(fieldManagerConflictError(pod.Name)).Times(1),
+					mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, false)),
+						gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName)), gomock.Eq(client.ForceOwnership)).
+						Return(nil).Times(1),
+				)
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			})
+			It("reverts resources forcing the ownership", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeTrue())
+				Expect(recordedEvents(recorder)).NotTo(
+					ContainElement(ContainSubstring(cpuboost.EventReasonRevertSkipped)))
+			})
+		})
+		When("POD was resized by another field manager", func() {
+			BeforeEach(func() {
+				pod.ManagedFields = []metav1.ManagedFieldsEntry{
+					{Manager: "kubectl-create", Operation: metav1.ManagedFieldsOperationUpdate},
+					{Manager: "vpa-updater", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "resize"},
+				}
+				mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Eq(pod),
+					gomock.Eq(bpod.NewRevertBoostLabelsPatch()),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).Return(nil).Times(1)
+			})
+			It("skips resources reversion and records an event", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeFalse())
+				Expect(recordedEvents(recorder)).To(ContainElement(ContainSubstring(cpuboost.EventReasonRevertSkipped)))
+			})
+		})
+		When("POD was resized by another field manager and drift policy is Delta", func() {
+			BeforeEach(func() {
+				spec.Spec.DriftPolicy = autoscaling.DriftPolicyDelta
+				pod.ManagedFields = []metav1.ManagedFieldsEntry{
+					{Manager: "vpa-updater", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "resize"},
+				}
+				gomock.InOrder(
+					mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, true)),
+						gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+						Return(fieldManagerConflictError(pod.Name)).Times(1),
+					mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Eq(revertApplyConfig(pod, true)),
+						gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName)), gomock.Eq(client.ForceOwnership)).
+						Return(nil).Times(1),
+				)
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			})
+			It("reverts the boost increase forcing the ownership", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeTrue())
+			})
+		})
+	})
+	Describe("Reports statistics", func() {
+		var scheduledTime time.Time
+		BeforeEach(func() {
+			scheduledTime = time.Now().Add(-10 * time.Second).Truncate(time.Second)
+			spec.Spec.DurationPolicy.Fixed = &autoscaling.FixedDurationPolicy{
+				Unit:  autoscaling.FixedDurationPolicyUnitSec,
+				Value: 60,
+			}
+			pod.Status.Conditions = []corev1.PodCondition{{
+				Type:               corev1.PodScheduled,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(scheduledTime),
+			}}
+			annot := &bpod.BoostPodAnnotation{
+				BoostTimestamp:    scheduledTime.Add(-time.Second),
+				InitCPURequests:   map[string]string{containerOneName: "500m"},
+				SkippedContainers: map[string]string{containerTwoName: cpuboost.EventReasonSkippedQoSChange},
+			}
+			annot.Apply(pod)
+		})
+		It("counts skipped containers and lists active PODs", func(ctx context.Context) {
+			boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+			Expect(err).NotTo(HaveOccurred())
+			secondPod := pod.DeepCopy()
+			secondPod.Name = "pod-002"
+			secondAnnot, err := bpod.BoostAnnotationFromPod(secondPod)
+			Expect(err).NotTo(HaveOccurred())
+			secondAnnot.BoostTimestamp = scheduledTime
+			secondAnnot.Apply(secondPod)
+
+			for _, p := range []*corev1.Pod{secondPod, pod} {
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  p,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			stats := boost.Stats()
+			Expect(stats.SkippedContainerBoosts).To(Equal(map[string]int{
+				cpuboost.EventReasonSkippedQoSChange: 2,
+			}))
+			Expect(stats.ActivePods).To(HaveLen(2))
+			Expect(stats.ActivePods[0].Name).To(Equal(pod.Name))
+			Expect(stats.ActivePods[1].Name).To(Equal(secondPod.Name))
+			Expect(stats.ActivePods[0].BoostTime).To(BeTemporally("==", scheduledTime.Add(-time.Second)))
+			Expect(stats.ActivePods[0].ExpectedRevertTime).NotTo(BeNil())
+			Expect(*stats.ActivePods[0].ExpectedRevertTime).To(BeTemporally("==", scheduledTime.Add(time.Minute)))
+		})
+	})
+	Describe("Accounts extra CPU", func() {
+		BeforeEach(func() {
+			annot := &bpod.BoostPodAnnotation{
+				BoostTimestamp:     time.Now().Add(-10 * time.Second),
+				InitCPURequests:    map[string]string{containerOneName: "500m"},
+				BoostedCPURequests: map[string]string{containerOneName: "1500m"},
+			}
+			annot.Apply(pod)
+		})
+		It("reports extra CPU of active PODs and core-seconds of untracked PODs", func(ctx context.Context) {
+			boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(boost.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodCreated, Pod: pod})).To(Succeed())
+			stats := boost.Stats()
+			Expect(stats.ExtraCPU.String()).To(Equal("1"))
+			Expect(stats.ExtraCPUCoreSeconds).To(BeZero())
+			Expect(metrics.BoostExtraCPU(boost.Namespace(), boost.Name())).To(Equal(float64(1)))
+
+			Expect(boost.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypePodDeleted, Pod: pod})).To(Succeed())
+			stats = boost.Stats()
+			Expect(stats.ExtraCPU.IsZero()).To(BeTrue())
+			Expect(stats.ExtraCPUCoreSeconds).To(BeNumerically("~", 10, 1))
+			Expect(metrics.BoostExtraCPU(boost.Namespace(), boost.Name())).To(BeZero())
+			Expect(metrics.BoostExtraCPUCoreSeconds(boost.Namespace(), boost.Name())).
+				To(BeNumerically("~", 10, 1))
+		})
+	})
+	Describe("Records POD startup latency", func() {
+		var readyPod *corev1.Pod
+		BeforeEach(func() {
+			scheduledTime := time.Now().Add(-time.Minute)
+			pod.Status.Conditions = []corev1.PodCondition{{
+				Type:               corev1.PodScheduled,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(scheduledTime),
+			}}
+			readyPod = pod.DeepCopy()
+			readyPod.Status.Conditions = append(readyPod.Status.Conditions, corev1.PodCondition{
+				Type:               corev1.PodReady,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(scheduledTime.Add(30 * time.Second)),
+			})
+		})
+		When("tracked POD becomes ready", func() {
+			It("records the latency once", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, event := range []*bpod.PodEvent{
+					{Type: bpod.PodEventTypePodCreated, Pod: pod},
+					{Type: bpod.PodEventTypeConditionChanged, Pod: readyPod},
+					{Type: bpod.PodEventTypeConditionChanged, Pod: readyPod},
+				} {
+					Expect(boost.HandlePodEvent(ctx, event)).To(Succeed())
+				}
+
+				Expect(metrics.PodStartupLatencyCount(boost.Namespace(), boost.Name())).To(Equal(uint64(1)))
+			})
+		})
+		When("POD is ready when it gets tracked", func() {
+			It("does not record the latency", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, event := range []*bpod.PodEvent{
+					{Type: bpod.PodEventTypePodCreated, Pod: readyPod},
+					{Type: bpod.PodEventTypeConditionChanged, Pod: readyPod},
+				} {
+					Expect(boost.HandlePodEvent(ctx, event)).To(Succeed())
+				}
+
+				Expect(metrics.PodStartupLatencyCount(boost.Namespace(), boost.Name())).To(BeZero())
+			})
+		})
+	})
+	Describe("Seeds statistics from the status", func() {
+		BeforeEach(func() {
+			spec.Status.TotalContainerBoosts = 10
+			spec.Status.SkippedContainerBoosts = []autoscaling.SkippedContainerBoosts{
+				{Reason: cpuboost.EventReasonSkippedQoSChange, Count: 3},
+			}
+			spec.Status.ExtraCPUCoreSeconds = 120
+			spec.Status.DryRunContainerBoosts = 4
+			dryRunExtraCPU := apiResource.MustParse("2")
+			spec.Status.DryRunExtraCPU = &dryRunExtraCPU
+		})
+		It("restores the total, skipped and dry-run container boosts and extra CPU core-seconds", func() {
+			boost, err := cpuboost.NewStartupCPUBoost(context.Background(), spec, config)
+			Expect(err).NotTo(HaveOccurred())
+			stats := boost.Stats()
+			Expect(stats.TotalContainerBoosts).To(Equal(10))
+			Expect(stats.SkippedContainerBoosts).To(Equal(map[string]int{
+				cpuboost.EventReasonSkippedQoSChange: 3,
+			}))
+			Expect(stats.ExtraCPUCoreSeconds).To(Equal(float64(120)))
+			Expect(stats.DryRunContainerBoosts).To(Equal(4))
+			Expect(stats.DryRunExtraCPU.String()).To(Equal("2"))
+		})
+		When("POD was boosted before the seed", func() {
+			BeforeEach(func() {
+				annot := &bpod.BoostPodAnnotation{
+					BoostTimestamp:    time.Now().Add(-time.Minute),
+					InitCPURequests:   map[string]string{containerOneName: "500m"},
+					SkippedContainers: map[string]string{containerTwoName: cpuboost.EventReasonSkippedQoSChange},
+				}
+				annot.Apply(pod)
+			})
+			It("rebuilds active counts without counting the POD again", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				stats := boost.Stats()
+				Expect(stats.ActiveContainerBoosts).To(Equal(1))
+				Expect(stats.TotalContainerBoosts).To(Equal(10))
+				Expect(stats.SkippedContainerBoosts[cpuboost.EventReasonSkippedQoSChange]).To(Equal(3))
+			})
+		})
+		When("POD was boosted after the seed", func() {
+			It("counts the POD", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+				annot := &bpod.BoostPodAnnotation{
+					BoostTimestamp:  time.Now().Add(time.Second),
+					InitCPURequests: map[string]string{containerOneName: "500m"},
+				}
+				annot.Apply(pod)
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boost.Stats().TotalContainerBoosts).To(Equal(11))
+			})
+		})
+		When("only dry-run statistics were seeded", func() {
+			BeforeEach(func() {
+				spec.Status.TotalContainerBoosts = 0
+				spec.Status.SkippedContainerBoosts = nil
+				annot := &bpod.BoostPodAnnotation{
+					BoostTimestamp:  time.Now().Add(-time.Minute),
+					InitCPURequests: map[string]string{containerOneName: "500m"},
+				}
+				annot.Apply(pod)
+			})
+			It("counts the POD boosted before the seed", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boost.Stats().TotalContainerBoosts).To(Equal(1))
+				Expect(boost.Stats().DryRunContainerBoosts).To(Equal(4))
+			})
+		})
+		When("POD with all containers skipped was admitted before the seed", func() {
+			BeforeEach(func() {
+				spec.Status.TotalContainerBoosts = 0
+				spec.Status.DryRunContainerBoosts = 0
+				annot := &bpod.BoostPodAnnotation{
+					BoostTimestamp:    time.Now().Add(-time.Minute),
+					SkippedContainers: map[string]string{containerTwoName: cpuboost.EventReasonSkippedQoSChange},
+				}
+				annot.Apply(pod)
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(),
+					gomock.Eq(bpod.NewRemoveBoostLabelPatch()),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).Return(nil).Times(1)
+			})
+			It("does not count the POD again", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boost.Stats().SkippedContainerBoosts[cpuboost.EventReasonSkippedQoSChange]).To(Equal(3))
+			})
+		})
+		When("boost is updated from the spec", func() {
+			It("never decreases the statistics", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				spec.Status.TotalContainerBoosts = 5
+				spec.Status.SkippedContainerBoosts[0].Count = 1
+				Expect(boost.UpdateFromSpec(ctx, spec)).To(Succeed())
+				Expect(boost.Stats().TotalContainerBoosts).To(Equal(10))
+				Expect(boost.Stats().SkippedContainerBoosts[cpuboost.EventReasonSkippedQoSChange]).To(Equal(3))
+
+				spec.Status.TotalContainerBoosts = 15
+				Expect(boost.UpdateFromSpec(ctx, spec)).To(Succeed())
+				Expect(boost.Stats().TotalContainerBoosts).To(Equal(15))
+			})
+		})
+	})
+	Describe("Records events", func() {
+		var recorder *events.FakeRecorder
+		BeforeEach(func() {
+			recorder = events.NewFakeRecorder(10)
+			config.EventRecorder = recorder
+		})
+		When("boosted POD is created", func() {
+			BeforeEach(func() {
+				annot := &bpod.BoostPodAnnotation{
+					BoostTimestamp:    time.Now(),
+					InitCPURequests:   map[string]string{containerOneName: "500m"},
+					InitCPULimits:     map[string]string{containerOneName: "1"},
+					SkippedContainers: map[string]string{containerTwoName: cpuboost.EventReasonSkippedQoSChange},
+				}
+				annot.Apply(pod)
+			})
+			It("records boosted and skipped containers on POD and boost", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recordedEvents(recorder)).To(Equal([]string{
+					"Normal Boosted CPU resources of containers container-one were boosted",
+					"Normal Boosted CPU resources of containers container-one were boosted",
+					"Normal SkippedQoSChange Container container-two was not boosted: " +
+						"resource increase would change the POD QoS class",
+					"Normal SkippedQoSChange Container container-two was not boosted: " +
+						"resource increase would change the POD QoS class",
+				}))
+			})
+			When("POD is already running", func() {
+				BeforeEach(func() {
+					pod.Status.Phase = corev1.PodRunning
+				})
+				It("does not record events", func(ctx context.Context) {
+					boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+						Type: bpod.PodEventTypePodCreated,
+						Pod:  pod,
+					})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(recordedEvents(recorder)).To(BeEmpty())
+				})
+			})
+		})
+		When("no POD container was boosted", func() {
+			var boost cpuboost.StartupCPUBoost
+			BeforeEach(func(ctx context.Context) {
+				delete(pod.Annotations, bpod.BoostAnnotationKey)
+				pod.UID = "pod-uid-001"
+				pod.Spec.Containers[0].Resources.Requests = nil
+				pod.Spec.Containers[0].Resources.Limits = nil
+				setContainerPercentagePolicy(spec, containerOneName, 100)
+				var err error
+				boost, err = cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+				_, err = boost.ApplyResourcePolicy(ctx, pod, bpod.BoostOverrides{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("does not account skipped containers on admission", func() {
+				Expect(recordedEvents(recorder)).To(BeEmpty())
+				Expect(boost.Stats().SkippedContainerBoosts).To(BeEmpty())
+				Expect(pod.Labels).To(HaveKeyWithValue(bpod.BoostLabelKey, boost.Name()))
+			})
+			It("accounts skipped containers once when POD is created", func(ctx context.Context) {
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(),
+					gomock.Eq(bpod.NewRemoveBoostLabelPatch()),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+					Return(errors.New("patch error")).Times(1)
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(),
+					gomock.Eq(bpod.NewRemoveBoostLabelPatch()),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+					DoAndReturn(applyPatch).Times(1)
+				Expect(boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  pod,
+				})).NotTo(Succeed())
+				Expect(boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})).To(Succeed())
+
+				Expect(recordedEvents(recorder)).To(Equal([]string{
+					"Normal SkippedNoCPUResources Container container-one was not boosted: " +
+						"container has no CPU resources to increase",
+					"Normal SkippedNoCPUResources Container container-one was not boosted: " +
+						"container has no CPU resources to increase",
+				}))
+				Expect(boost.Stats().SkippedContainerBoosts).To(Equal(map[string]int{
+					cpuboost.EventReasonSkippedNoCPUResources: 1,
+				}))
+				Expect(metrics.SkippedContainers(boost.Namespace(), boost.Name(),
+					cpuboost.EventReasonSkippedNoCPUResources)).To(Equal(float64(1)))
+				_, tracked := boost.Pod(pod.Name)
+				Expect(tracked).To(BeFalse())
+				Expect(pod.Labels).NotTo(HaveKey(bpod.BoostLabelKey))
+			})
+		})
+		When("POD resources reversion fails", func() {
+			BeforeEach(func() {
+				spec.Spec.DurationPolicy.PodCondition = &autoscaling.PodConditionDurationPolicy{
+					Type:   corev1.PodReady,
+					Status: corev1.ConditionTrue,
+				}
+				pod.Status.Conditions = []corev1.PodCondition{{
+					Type:   corev1.PodReady,
+					Status: corev1.ConditionTrue,
+				}}
+				mockSubResourceClient := mock.NewMockSubResourceClient(mockCtrl)
+				mockSubResourceClient.EXPECT().Apply(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("apply failed")).Times(1)
+				mockClient.EXPECT().SubResource("resize").Return(mockSubResourceClient).Times(1)
+			})
+			It("records revert failure on POD and boost", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypeConditionChanged,
+					Pod:  pod,
+				})
+
+				Expect(err).To(HaveOccurred())
+				stats := boost.Stats()
+				Expect(stats.FailedReverts).To(Equal(1))
+				Expect(metrics.RevertFailures(boost.Namespace(), boost.Name())).To(Equal(float64(1)))
+				Expect(stats.LastRevertError).To(ContainSubstring("apply failed"))
+				Expect(recordedEvents(recorder)).To(ContainElements(
+					ContainSubstring("Warning RevertFailed Failed to revert CPU resources"),
+					ContainSubstring("Warning RevertFailed Failed to revert CPU resources"),
+				))
+			})
+		})
+	})
+	Describe("Handles POD deleted event", func() {
+		When("POD exists", func() {
+			It("removes POD, updates stats and metrics", func(ctx context.Context) {
+				boost, err := cpuboost.NewStartupCPUBoost(ctx, spec, config)
+				Expect(err).NotTo(HaveOccurred())
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  pod,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodDeleted,
+					Pod:  pod,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeFalse())
+				stats := boost.Stats()
+				Expect(stats.ActiveContainerBoosts).To(Equal(0))
+				Expect(stats.TotalContainerBoosts).To(Equal(2))
+				Expect(metrics.BoostContainersActive(boost.Namespace(), boost.Name())).To(Equal(float64(0)))
+				Expect(metrics.BoostContainersTotal(boost.Namespace(), boost.Name())).To(Equal(float64(2)))
+			})
+		})
+	})
+	Describe("Updates boost from the spec", func() {
+		var (
+			updatedSpec *autoscaling.StartupCPUBoost
+		)
+		BeforeEach(func() {
+			spec.Spec.Selector = metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app": "test",
+				},
+			}
+			spec.Spec.DurationPolicy.Fixed = &autoscaling.FixedDurationPolicy{
+				Unit:  autoscaling.FixedDurationPolicyUnitMin,
+				Value: 2,
+			}
+			spec.Spec.DurationPolicy.PodCondition = &autoscaling.PodConditionDurationPolicy{
+				Status: corev1.ConditionTrue,
+				Type:   corev1.PodReady,
+			}
+			updatedSpec = spec.DeepCopy()
+		})
+		JustBeforeEach(func() {
+			boost, err = cpuboost.NewStartupCPUBoost(context.Background(), spec, config)
+			Expect(err).ShouldNot(HaveOccurred())
+			err = boost.UpdateFromSpec(context.TODO(), updatedSpec)
+		})
+		When("selector is changed", func() {
+			var (
+				podToSelect *corev1.Pod
+			)
+			BeforeEach(func() {
+				updatedSpec.Spec.Selector = metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"app": "newApp",
+					},
+				}
+				podToSelect = &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-pod",
+						Namespace: specTemplate.Namespace,
+						Labels: map[string]string{
+							"app": "newApp",
+						}}}
+			})
+			It("matches pod with new selector", func() {
+				Expect(boost.Matches(context.TODO(), podToSelect)).To(BeTrue())
+			})
+		})
+		When("priority is changed", func() {
+			BeforeEach(func() {
+				updatedSpec.Spec.Priority = 100
+			})
+			It("has the new priority", func() {
+				Expect(boost.Priority()).To(Equal(int32(100)))
+			})
+		})
+		When("boost is suspended", func() {
+			BeforeEach(func() {
+				updatedSpec.Spec.Suspend = true
+			})
+			It("is suspended", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(boost.Suspended()).To(BeTrue())
+			})
+		})
+		When("duration policy is changed", func() {
+			var (
+				durationPolicies map[string]duration.Policy
+			)
+			BeforeEach(func() {
+				updatedSpec.Spec.DurationPolicy.Fixed = &autoscaling.FixedDurationPolicy{
+					Unit:  autoscaling.FixedDurationPolicyUnitMin,
+					Value: 1000,
+				}
+				updatedSpec.Spec.DurationPolicy.PodCondition = &autoscaling.PodConditionDurationPolicy{
+					Type:   corev1.PodInitialized,
+					Status: corev1.ConditionTrue,
+				}
+			})
+			JustBeforeEach(func() {
+				durationPolicies = boost.DurationPolicies()
+			})
+			It("has valid fixed duration policy", func() {
+				durationPolicy := durationPolicies[duration.FixedDurationPolicyName]
+				Expect(durationPolicy).To(BeAssignableToTypeOf(&duration.FixedDurationPolicy{}))
+				fixedDurationPolicy := durationPolicy.(*duration.FixedDurationPolicy)
+				Expect(fixedDurationPolicy.Duration()).To(Equal(1000 * time.Minute))
+			})
+			It("has valid pod condition policy", func() {
+				durationPolicy := durationPolicies[duration.PodConditionPolicyName]
+				Expect(durationPolicy).To(BeAssignableToTypeOf(&duration.PodConditionPolicy{}))
+				podConditionDurationPolicy := durationPolicy.(*duration.PodConditionPolicy)
+				Expect(podConditionDurationPolicy.Condition()).To(Equal(corev1.PodInitialized))
+				Expect(podConditionDurationPolicy.Status()).To(Equal(corev1.ConditionTrue))
+			})
+		})
+		When("resource policy is changed", func() {
+			BeforeEach(func() {
+				updatedSpec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
+					ContainerPolicies: []autoscaling.ContainerPolicy{
+						{
+							MatchContainers: &autoscaling.MatchContainers{
+								Type:  autoscaling.MatchContainersTypeExactName,
+								Value: "test",
+							},
+							PercentageIncrease: &autoscaling.PercentageIncrease{
+								Value: 1000,
+							},
+						},
+					},
+				}
+
+			})
+			JustBeforeEach(func() {
+				err = boost.UpdateFromSpec(context.TODO(), updatedSpec)
+			})
+			It("applies updated resource policy", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				pod := podTemplate.DeepCopy()
+				pod.Spec.Containers[0].Name = "test"
+				setContainerResource(pod, 0, corev1.ResourceCPU, "1", "2")
+
+				_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+				Expect(err).NotTo(HaveOccurred())
+
+				// 1 CPU * 1000% = 11 CPU (10 + 1) -> 11000m
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(11000)))
+			})
+		})
+	})
+	Describe("Applies POD and namespace annotation overrides", func() {
+		var (
+			configSpec *autoscaling.StartupCPUBoost
+			pod        *corev1.Pod
+			overrides  bpod.BoostOverrides
+			result     cpuboost.ResourcePolicyResult
+			err        error
+		)
+		BeforeEach(func() {
+			pod = podTemplate.DeepCopy()
+			delete(pod.Annotations, bpod.BoostAnnotationKey)
+			configSpec = specTemplate.DeepCopy()
+			setContainerPercentagePolicy(configSpec, "container-one", 100)
+			configSpec.Spec.DurationPolicy.Fixed = &autoscaling.FixedDurationPolicy{
+				Unit:  autoscaling.FixedDurationPolicyUnitSec,
+				Value: 60,
+			}
+			configSpec.Spec.Overrides = &autoscaling.OverridePolicy{
+				Percentage: &autoscaling.PercentageOverrideRange{Min: 20, Max: 200},
+				Duration: &autoscaling.DurationOverrideRange{
+					Unit: autoscaling.FixedDurationPolicyUnitSec,
+					Min:  30,
+					Max:  300,
+				},
+			}
+			percentage := int64(50)
+			d := 3 * time.Minute
+			overrides = bpod.BoostOverrides{Percentage: &percentage, Duration: &d}
+		})
+		JustBeforeEach(func() {
+			boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
+			Expect(err).NotTo(HaveOccurred())
+			result, err = boost.ApplyResourcePolicy(context.Background(), pod, overrides)
+		})
+		When("overrides are within the allowed range", func() {
+			It("applies the overridden percentage", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(1500)))
+				Expect(result.Warnings).To(BeEmpty())
+			})
+			It("records the overridden duration in the annotation", func() {
+				annot, err := bpod.BoostAnnotationFromPod(pod)
+				Expect(err).NotTo(HaveOccurred())
+				d, ok := annot.FixedDurationOverride()
+				Expect(ok).To(BeTrue())
+				Expect(d).To(Equal(3 * time.Minute))
+			})
+		})
+		When("overrides are outside of the allowed range", func() {
+			BeforeEach(func() {
+				percentage := int64(500)
+				d := 10 * time.Minute
+				overrides = bpod.BoostOverrides{Percentage: &percentage, Duration: &d}
+			})
+			It("applies the boost settings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(2000)))
+				annot, err := bpod.BoostAnnotationFromPod(pod)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(annot.FixedDuration).To(BeEmpty())
+			})
+			It("returns the warnings", func() {
+				Expect(result.Warnings).To(ConsistOf(
+					"percentage override 500 is ignored as it is outside of the allowed range [20, 200]",
+					"duration override 10m0s is ignored as it is outside of the allowed range [30s, 5m0s]",
+				))
+			})
+		})
+		When("boost does not allow overrides", func() {
+			BeforeEach(func() {
+				configSpec.Spec.Overrides = nil
+				overrides.Warnings = []string{"malformed annotation"}
+			})
+			It("applies the boost settings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(2000)))
+			})
+			It("returns the warnings", func() {
+				Expect(result.Warnings).To(ConsistOf(
+					"malformed annotation",
+					"percentage override 50 is ignored as it is not allowed by the boost",
+					"duration override 3m0s is ignored as it is not allowed by the boost",
+				))
+			})
+		})
+		When("boost has no fixed duration policy", func() {
+			BeforeEach(func() {
+				configSpec.Spec.DurationPolicy.Fixed = nil
+			})
+			It("returns the warning on the duration override", func() {
+				Expect(result.Warnings).To(ConsistOf(
+					"duration override 3m0s is ignored as the boost has no fixed duration policy",
+				))
+			})
+		})
+	})
+	Describe("Applies boost profiles", func() {
+		var (
+			configSpec *autoscaling.StartupCPUBoost
+			pod        *corev1.Pod
+			overrides  bpod.BoostOverrides
+			profiles   map[client.ObjectKey]autoscaling.StartupCPUBoostProfileSpec
+			boost      cpuboost.StartupCPUBoost
+			result     cpuboost.ResourcePolicyResult
+			err        error
+		)
+		BeforeEach(func() {
+			pod = podTemplate.DeepCopy()
+			delete(pod.Annotations, bpod.BoostAnnotationKey)
+			configSpec = specTemplate.DeepCopy()
+			configSpec.Spec.ProfileRef = &autoscaling.ProfileReference{Name: "jvm"}
+			overrides = bpod.BoostOverrides{}
+			profiles = map[client.ObjectKey]autoscaling.StartupCPUBoostProfileSpec{
+				{Namespace: "demo", Name: "jvm"}: {
+					ResourcePolicy: autoscaling.ResourcePolicy{
+						ContainerPolicies: []autoscaling.ContainerPolicy{{
+							MatchContainers: &autoscaling.MatchContainers{
+								Type:  autoscaling.MatchContainersTypeExactName,
+								Value: "container-one",
+							},
+							PercentageIncrease: &autoscaling.PercentageIncrease{Value: 100},
+						}},
+					},
+					DurationPolicy: autoscaling.DurationPolicy{
+						Fixed: &autoscaling.FixedDurationPolicy{Unit: autoscaling.FixedDurationPolicyUnitMin, Value: 2},
+					},
+				},
+				{Name: "fast"}: {
+					ResourcePolicy: autoscaling.ResourcePolicy{
+						ContainerPolicies: []autoscaling.ContainerPolicy{{
+							MatchContainers: &autoscaling.MatchContainers{
+								Type:  autoscaling.MatchContainersTypeExactName,
+								Value: "container-two",
+							},
+							PercentageIncrease: &autoscaling.PercentageIncrease{Value: 50},
+						}},
+					},
+					DurationPolicy: autoscaling.DurationPolicy{
+						Fixed: &autoscaling.FixedDurationPolicy{Unit: autoscaling.FixedDurationPolicyUnitSec, Value: 30},
+					},
+				},
+			}
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+				DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					spec, ok := profiles[key]
+					if !ok {
+						return apierrors.NewNotFound(schema.GroupResource{Resource: "startupcpuboostprofiles"}, key.Name)
+					}
+					switch profile := obj.(type) {
+					case *autoscaling.StartupCPUBoostProfile:
+						profile.Spec = *spec.DeepCopy()
+					case *autoscaling.ClusterStartupCPUBoostProfile:
+						profile.Spec = *spec.DeepCopy()
+					}
+					return nil
+				})
+			config.ProfileResolver = cpuboost.NewProfileResolver(mockClient)
+		})
+		JustBeforeEach(func() {
+			boost, err = cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
+			Expect(err).NotTo(HaveOccurred())
+			result, err = boost.ApplyResourcePolicy(context.Background(), pod, overrides)
+		})
+		When("profile resolver is not configured", func() {
+			It("errors", func() {
+				config.ProfileResolver = nil
+				_, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
+				Expect(err).To(MatchError(cpuboost.ErrNilProfileResolver))
+			})
+		})
+		When("boost references the profile", func() {
+			It("applies the resource policy of the profile", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(2000)))
+				Expect(pod.Spec.Containers[1].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(1000)))
+			})
+			It("uses the duration policy of the profile", func() {
+				p, ok := boost.DurationPolicies()[duration.FixedDurationPolicyName]
+				Expect(ok).To(BeTrue())
+				Expect(p.(*duration.FixedDurationPolicy).Duration()).To(Equal(2 * time.Minute))
+			})
+		})
+		When("boost overrides the profile fields", func() {
+			BeforeEach(func() {
+				setContainerPercentagePolicy(configSpec, "container-one", 20)
+				configSpec.Spec.DurationPolicy.Fixed = &autoscaling.FixedDurationPolicy{
+					Unit:  autoscaling.FixedDurationPolicyUnitSec,
+					Value: 45,
+				}
+			})
+			It("applies the resource policy of the boost", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(1200)))
+			})
+			It("uses the duration policy of the boost", func() {
+				p := boost.DurationPolicies()[duration.FixedDurationPolicyName]
+				Expect(p.(*duration.FixedDurationPolicy).Duration()).To(Equal(45 * time.Second))
+			})
+		})
+		When("referenced profile does not exist", func() {
+			BeforeEach(func() {
+				configSpec.Spec.ProfileRef.Name = "missing"
+				setContainerPercentagePolicy(configSpec, "container-two", 20)
+			})
+			It("returns the profile resolution error", func() {
+				Expect(boost.ProfileError()).To(Satisfy(apierrors.IsNotFound))
+			})
+			It("applies the boost spec only", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(1000)))
+				Expect(pod.Spec.Containers[1].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(1200)))
+			})
+			It("applies the profile once it is resolved", func(ctx context.Context) {
+				Expect(boost.ResolveProfile(ctx)).To(Satisfy(apierrors.IsNotFound))
+				profiles[client.ObjectKey{Namespace: "demo", Name: "missing"}] =
+					profiles[client.ObjectKey{Namespace: "demo", Name: "jvm"}]
+
+				Expect(boost.ResolveProfile(ctx)).To(Succeed())
+				Expect(boost.ProfileError()).NotTo(HaveOccurred())
+				pod = podTemplate.DeepCopy()
+				delete(pod.Annotations, bpod.BoostAnnotationKey)
+				_, err = boost.ApplyResourcePolicy(ctx, pod, overrides)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(2000)))
+				p, ok := boost.DurationPolicies()[duration.FixedDurationPolicyName]
+				Expect(ok).To(BeTrue())
+				Expect(p.(*duration.FixedDurationPolicy).Duration()).To(Equal(2 * time.Minute))
+			})
+		})
+		When("boost profile is updated", func() {
+			It("applies the updated profile on update from spec", func() {
+				spec := profiles[client.ObjectKey{Namespace: "demo", Name: "jvm"}]
+				spec.ResourcePolicy.ContainerPolicies[0].PercentageIncrease.Value = 300
+				Expect(boost.UpdateFromSpec(context.Background(), configSpec)).To(Succeed())
+				Expect(boost.ProfileError()).NotTo(HaveOccurred())
+				pod = podTemplate.DeepCopy()
+				delete(pod.Annotations, bpod.BoostAnnotationKey)
+				_, err = boost.ApplyResourcePolicy(context.Background(), pod, overrides)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(4000)))
+			})
+		})
+		When("boost is updated to reference the profile that does not exist", func() {
+			It("returns the profile resolution error", func() {
+				configSpec.Spec.ProfileRef.Name = "missing"
+				Expect(boost.UpdateFromSpec(context.Background(), configSpec)).To(Succeed())
+				Expect(boost.ProfileError()).To(Satisfy(apierrors.IsNotFound))
+			})
+		})
+		When("POD references the profile with the annotation", func() {
+			BeforeEach(func() {
+				overrides.Profile = "ClusterStartupCPUBoostProfile/fast"
+			})
+			It("applies the resource policy of the POD profile instead of the boost one", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Warnings).To(BeEmpty())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(1000)))
+				Expect(pod.Spec.Containers[1].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(1500)))
+			})
+			It("records the duration of the POD profile in the annotation", func() {
+				annot, err := bpod.BoostAnnotationFromPod(pod)
+				Expect(err).NotTo(HaveOccurred())
+				d, ok := annot.FixedDurationOverride()
+				Expect(ok).To(BeTrue())
+				Expect(d).To(Equal(30 * time.Second))
+			})
+		})
+		When("POD references the profile that does not exist", func() {
+			BeforeEach(func() {
+				overrides.Profile = "missing"
+			})
+			It("applies the boost profile and returns the warning", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(2000)))
+				Expect(result.Warnings).To(HaveLen(1))
+				Expect(result.Warnings[0]).To(HavePrefix("profile missing is ignored: "))
+			})
+		})
+	})
+	Describe("Applies resource policy in the DryRun mode", func() {
+		var (
+			recorder *events.FakeRecorder
+			original *corev1.Pod
+			result   cpuboost.ResourcePolicyResult
+		)
+		BeforeEach(func() {
+			recorder = events.NewFakeRecorder(10)
+			config.EventRecorder = recorder
+			spec.Spec.Mode = autoscaling.BoostModeDryRun
+			setContainerPercentagePolicy(spec, containerOneName, 100)
+			delete(pod.Annotations, bpod.BoostAnnotationKey)
+			delete(pod.Labels, bpod.BoostLabelKey)
+			setContainerResource(pod, 0, corev1.ResourceCPU, "500m", "1")
+			original = pod.DeepCopy()
+		})
+		JustBeforeEach(func(ctx context.Context) {
+			boost, err = cpuboost.NewStartupCPUBoost(ctx, spec, config)
+			Expect(err).NotTo(HaveOccurred())
+			result, err = boost.ApplyResourcePolicy(ctx, pod, bpod.BoostOverrides{})
+		})
+		It("does not change the POD resources", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.DryRun).To(BeTrue())
+			Expect(pod.Spec).To(Equal(original.Spec))
+			Expect(pod.Labels).To(HaveKeyWithValue(bpod.BoostLabelKey, spec.Name))
+			Expect(pod.Annotations).NotTo(HaveKey(bpod.BoostAnnotationKey))
+		})
+		It("describes the boost in the dry-run annotation", func() {
+			annot, err := bpod.DryRunAnnotationFromPod(pod)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annot.BoostName).To(Equal(spec.Name))
+			Expect(annot.QOSClass).To(Equal(bpod.ComputePodQOS(original, false)))
+			Expect(annot.InitCPURequests).To(HaveKeyWithValue(containerOneName, "500m"))
+			Expect(annot.BoostedCPURequests).To(HaveKeyWithValue(containerOneName, "1"))
+			Expect(annot.BoostedCPULimits).To(HaveKeyWithValue(containerOneName, "2"))
+		})
+		It("does not account the POD on admission", func() {
+			Expect(recordedEvents(recorder)).To(BeEmpty())
+			Expect(boost.Stats().DryRunContainerBoosts).To(BeZero())
+			Expect(metrics.DryRunContainers(boost.Namespace(), boost.Name())).To(BeZero())
+		})
+		When("POD is created", func() {
+			var patchErr error
+			BeforeEach(func() {
+				patchErr = nil
+				pod.UID = "pod-uid-001"
+				mockClient.EXPECT().Patch(gomock.Any(), gomock.Any(),
+					gomock.Eq(bpod.NewRemoveBoostLabelPatch()),
+					gomock.Eq(client.FieldOwner(cpuboost.FieldManagerName))).
+					DoAndReturn(func(ctx context.Context, obj client.Object, patch client.Patch,
+						opts ...client.PatchOption) error {
+						if patchErr != nil {
+							return patchErr
+						}
+						return applyPatch(ctx, obj, patch, opts...)
+					}).MinTimes(1)
+			})
+			JustBeforeEach(func(ctx context.Context) {
+				err = boost.HandlePodEvent(ctx, &bpod.PodEvent{
+					Type: bpod.PodEventTypePodCreated,
+					Pod:  pod,
+				})
+			})
+			It("removes the boost label", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pod.Labels).NotTo(HaveKey(bpod.BoostLabelKey))
+				Expect(pod.Annotations).To(HaveKey(bpod.DryRunAnnotationKey))
+			})
+			It("records the events", func() {
+				note := "CPU resources of containers container-one would be boosted by 500m CPU requests, " +
+					"keeping the " + string(bpod.ComputePodQOS(original, false)) + " QoS class"
+				Expect(recordedEvents(recorder)).To(Equal([]string{
+					"Normal DryRun " + note,
+					"Normal DryRun " + note,
+				}))
+			})
+			It("updates the stats and metrics", func() {
+				stats := boost.Stats()
+				Expect(stats.DryRunContainerBoosts).To(Equal(1))
+				Expect(stats.DryRunExtraCPU.String()).To(Equal("500m"))
+				Expect(stats.TotalContainerBoosts).To(BeZero())
+				Expect(metrics.DryRunContainers(boost.Namespace(), boost.Name())).To(Equal(float64(1)))
+			})
+			It("does not track the POD", func() {
+				_, found := boost.Pod(pod.Name)
+				Expect(found).To(BeFalse())
+			})
+			When("boost label removal fails", func() {
+				BeforeEach(func() {
+					patchErr = errors.New("patch error")
+				})
+				It("does not account the POD again on update", func(ctx context.Context) {
+					Expect(err).To(HaveOccurred())
+					patchErr = nil
+					Expect(boost.HandlePodEvent(ctx, &bpod.PodEvent{
+						Type: bpod.PodEventTypeConditionChanged,
+						Pod:  pod,
+					})).To(Succeed())
+					Expect(boost.Stats().DryRunContainerBoosts).To(Equal(1))
+					Expect(pod.Labels).NotTo(HaveKey(bpod.BoostLabelKey))
+				})
+				It("accounts the POD recreated with the same name", func(ctx context.Context) {
+					Expect(err).To(HaveOccurred())
+					patchErr = nil
+					recreated := pod.DeepCopy()
+					recreated.UID = "pod-uid-002"
+					Expect(boost.HandlePodEvent(ctx, &bpod.PodEvent{
+						Type: bpod.PodEventTypePodCreated,
+						Pod:  recreated,
+					})).To(Succeed())
+					Expect(boost.Stats().DryRunContainerBoosts).To(Equal(2))
+				})
+			})
+		})
+		When("webhook is reinvoked", func() {
+			It("does not evaluate the POD again", func(ctx context.Context) {
+				evaluated := pod.DeepCopy()
+				result, err = boost.ApplyResourcePolicy(ctx, pod, bpod.BoostOverrides{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.DryRun).To(BeTrue())
+				Expect(pod).To(Equal(evaluated))
+			})
+		})
+		When("boost is updated to the Boost mode", func() {
+			It("changes the POD resources", func(ctx context.Context) {
+				spec.Spec.Mode = autoscaling.BoostModeBoost
+				Expect(boost.UpdateFromSpec(ctx, spec)).To(Succeed())
+				newPod := original.DeepCopy()
+				result, err = boost.ApplyResourcePolicy(ctx, newPod, bpod.BoostOverrides{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.DryRun).To(BeFalse())
+				Expect(newPod.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("1"))
+				Expect(newPod.Annotations).To(HaveKey(bpod.BoostAnnotationKey))
+				Expect(newPod.Annotations).NotTo(HaveKey(bpod.DryRunAnnotationKey))
+			})
+		})
+	})
+	Describe("Validates fixed duration policy with the POD duration override", func() {
+		var (
+			boost    cpuboost.StartupCPUBoost
+			pod      *corev1.Pod
+			violated []*corev1.Pod
+		)
+		BeforeEach(func() {
+			spec := specTemplate.DeepCopy()
+			spec.Spec.DurationPolicy.Fixed = &autoscaling.FixedDurationPolicy{
+				Unit:  autoscaling.FixedDurationPolicyUnitSec,
+				Value: 60,
+			}
+			var err error
+			boost, err = cpuboost.NewStartupCPUBoost(context.Background(), spec, config)
+			Expect(err).NotTo(HaveOccurred())
+			pod = podTemplate.DeepCopy()
+			pod.Status.Conditions = []corev1.PodCondition{{
+				Type:               corev1.PodScheduled,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+			}}
+		})
+		JustBeforeEach(func() {
+			Expect(boost.HandlePodEvent(context.TODO(), &bpod.PodEvent{
+				Type: bpod.PodEventTypePodCreated,
+				Pod:  pod,
+			})).To(Succeed())
+			violated = boost.ValidatePolicy(context.TODO(), duration.FixedDurationPolicyName)
+		})
+		When("POD has no duration override", func() {
+			It("returns the POD violating the policy", func() {
+				Expect(violated).To(HaveLen(1))
+			})
+		})
+		When("POD has the duration override", func() {
+			BeforeEach(func() {
+				annot := *annotTemplate
+				annot.FixedDuration = "5m0s"
+				annot.Apply(pod)
+			})
+			It("does not return the POD", func() {
+				Expect(violated).To(BeEmpty())
+			})
+		})
+	})
+	Describe("ApplyResourcePolicy", func() {
+		When("POD has no containers that match policy", func() {
+			It("Does not change POD", func() {
 				pod := podTemplate.DeepCopy()
 				delete(pod.Annotations, bpod.BoostAnnotationKey)
 				originalPod := pod.DeepCopy()
 
 				configSpec := specTemplate.DeepCopy()
 				setContainerPercentagePolicy(configSpec, "non-existent-container", 100)
-				boost, err := cpuboost.NewStartupCPUBoost(configSpec, config)
+				boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
 				Expect(err).NotTo(HaveOccurred())
 
-				err = boost.ApplyResourcePolicy(context.Background(), pod)
+				_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(pod.Spec.Containers).To(Equal(originalPod.Spec.Containers))
@@ -543,15 +1905,21 @@ var _ = Describe("StartupCPUBoost", func() {
 
 					configSpec := specTemplate.DeepCopy()
 					setContainerPercentagePolicy(configSpec, "container-one", 100)
-					boost, err := cpuboost.NewStartupCPUBoost(configSpec, config)
+					boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
 					Expect(err).NotTo(HaveOccurred())
 
-					err = boost.ApplyResourcePolicy(context.Background(), pod)
+					result, err := boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
 
 					Expect(err).NotTo(HaveOccurred())
 					Expect(pod.Spec.Containers).To(Equal(originalPod.Spec.Containers))
-					_, ok := pod.Annotations[bpod.BoostAnnotationKey]
-					Expect(ok).To(BeFalse())
+					expectSkippedContainersRecorded(pod, map[string]string{
+						"container-one": cpuboost.EventReasonSkippedRestartPolicy,
+					})
+					Expect(result.SkippedContainers).To(Equal([]cpuboost.SkippedContainer{{
+						Name:    "container-one",
+						Reason:  cpuboost.EventReasonSkippedRestartPolicy,
+						Message: "CPU resize requires container restart",
+					}}))
 				})
 			})
 			When("container has no CPU resources", func() {
@@ -564,15 +1932,16 @@ var _ = Describe("StartupCPUBoost", func() {
 
 					configSpec := specTemplate.DeepCopy()
 					setContainerPercentagePolicy(configSpec, "container-one", 100)
-					boost, err := cpuboost.NewStartupCPUBoost(configSpec, config)
+					boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
 					Expect(err).NotTo(HaveOccurred())
 
-					err = boost.ApplyResourcePolicy(context.Background(), pod)
+					_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
 
 					Expect(err).NotTo(HaveOccurred())
 					Expect(pod.Spec.Containers).To(Equal(originalPod.Spec.Containers))
-					_, ok := pod.Annotations[bpod.BoostAnnotationKey]
-					Expect(ok).To(BeFalse())
+					expectSkippedContainersRecorded(pod, map[string]string{
+						"container-one": cpuboost.EventReasonSkippedNoCPUResources,
+					})
 				})
 			})
 			When("container resource increase changes POD's QOS class", func() {
@@ -587,15 +1956,258 @@ var _ = Describe("StartupCPUBoost", func() {
 
 					configSpec := specTemplate.DeepCopy()
 					setContainerFixedPolicy(configSpec, "container-one", "2", "2")
-					boost, err := cpuboost.NewStartupCPUBoost(configSpec, config)
+					boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
 					Expect(err).NotTo(HaveOccurred())
 
-					err = boost.ApplyResourcePolicy(context.Background(), pod)
+					result, err := boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
 
 					Expect(err).NotTo(HaveOccurred())
 					Expect(pod.Spec.Containers).To(Equal(originalPod.Spec.Containers))
-					_, ok := pod.Annotations[bpod.BoostAnnotationKey]
-					Expect(ok).To(BeFalse())
+					expectSkippedContainersRecorded(pod, map[string]string{
+						"container-one": cpuboost.EventReasonSkippedQoSChange,
+					})
+					Expect(result.SkippedContainers).To(Equal([]cpuboost.SkippedContainer{{
+						Name:    "container-one",
+						Reason:  cpuboost.EventReasonSkippedQoSChange,
+						Message: "QoS would change from Burstable to Guaranteed",
+					}}))
+				})
+			})
+			When("POD containers were skipped by a former webhook invocation", func() {
+				It("does not report the skipped containers again", func(ctx context.Context) {
+					recorder := events.NewFakeRecorder(10)
+					config.EventRecorder = recorder
+					pod := podTemplate.DeepCopy()
+					delete(pod.Annotations, bpod.BoostAnnotationKey)
+					pod.Spec.Containers[0].Resources.Requests = nil
+					pod.Spec.Containers[0].Resources.Limits = nil
+					configSpec := specTemplate.DeepCopy()
+					setContainerPercentagePolicy(configSpec, "container-one", 100)
+					boost, err := cpuboost.NewStartupCPUBoost(ctx, configSpec, config)
+					Expect(err).NotTo(HaveOccurred())
+					result, err := boost.ApplyResourcePolicy(ctx, pod, bpod.BoostOverrides{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.SkippedContainers).To(HaveLen(1))
+					reinvokedPod := pod.DeepCopy()
+
+					result, err = boost.ApplyResourcePolicy(ctx, reinvokedPod, bpod.BoostOverrides{})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.SkippedContainers).To(BeEmpty())
+					Expect(reinvokedPod).To(Equal(pod))
+					Expect(recordedEvents(recorder)).To(BeEmpty())
+					Expect(boost.Stats().SkippedContainerBoosts).To(BeEmpty())
+				})
+			})
+			When("POD was already boosted by a former webhook invocation", func() {
+				var (
+					boost      cpuboost.StartupCPUBoost
+					pod        *corev1.Pod
+					firstAnnot *bpod.BoostPodAnnotation
+				)
+				BeforeEach(func() {
+					pod = podTemplate.DeepCopy()
+					delete(pod.Annotations, bpod.BoostAnnotationKey)
+					configSpec := specTemplate.DeepCopy()
+					configSpec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
+						ContainerPolicies: []autoscaling.ContainerPolicy{
+							{
+								MatchContainers: &autoscaling.MatchContainers{
+									Type:  autoscaling.MatchContainersTypeRegexName,
+									Value: "^container-.*$",
+								},
+								PercentageIncrease: &autoscaling.PercentageIncrease{Value: 100},
+							},
+						},
+					}
+					var err error
+					boost, err = cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
+					Expect(err).NotTo(HaveOccurred())
+				})
+				It("does not change the POD again", func() {
+					_, err := boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+					Expect(err).NotTo(HaveOccurred())
+					boostedPod := pod.DeepCopy()
+
+					_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pod).To(Equal(boostedPod))
+				})
+				It("boosts only the containers added after the former invocation", func() {
+					injected := pod.Spec.Containers[1].DeepCopy()
+					pod.Spec.Containers = pod.Spec.Containers[:1]
+					_, err := boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+					Expect(err).NotTo(HaveOccurred())
+					firstAnnot, err = bpod.BoostAnnotationFromPod(pod)
+					Expect(err).NotTo(HaveOccurred())
+					boostedRequests := pod.Spec.Containers[0].Resources.Requests.Cpu().DeepCopy()
+					pod.Spec.Containers = append(pod.Spec.Containers, *injected)
+
+					_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().Cmp(boostedRequests)).To(Equal(0))
+					Expect(pod.Spec.Containers[1].Resources.Requests.Cpu().Cmp(
+						*injected.Resources.Requests.Cpu())).To(Equal(1))
+					annot, err := bpod.BoostAnnotationFromPod(pod)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(annot.InitCPURequests).To(HaveKey(containerOneName))
+					Expect(annot.InitCPURequests).To(HaveKey(containerTwoName))
+					Expect(annot.BoostTimestamp).To(BeTemporally("==", firstAnnot.BoostTimestamp))
+					Expect(annot.Transitions).To(HaveLen(len(firstAnnot.Transitions)))
+				})
+			})
+			When("container policy matches the container image", func() {
+				It("boosts only the containers running the image", func() {
+					pod := podTemplate.DeepCopy()
+					delete(pod.Annotations, bpod.BoostAnnotationKey)
+					pod.Spec.Containers[0].Image = "docker.io/library/eclipse-temurin:21-jre"
+					pod.Spec.Containers[1].Image = "docker.io/library/eclipse-temurin:17-jre"
+					configSpec := specTemplate.DeepCopy()
+					configSpec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
+						ContainerPolicies: []autoscaling.ContainerPolicy{
+							{
+								MatchContainers: &autoscaling.MatchContainers{
+									Type:  autoscaling.MatchContainersTypeImage,
+									Value: "/eclipse-temurin$",
+									Tag:   "21-jre",
+								},
+								PercentageIncrease: &autoscaling.PercentageIncrease{Value: 100},
+							},
+						},
+					}
+					boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("2"))
+					Expect(pod.Spec.Containers[1].Resources.Requests.Cpu().String()).To(Equal("1"))
+				})
+			})
+			When("container policy has composite container matcher", func() {
+				It("boosts only the included containers that are not excluded", func() {
+					pod := podTemplate.DeepCopy()
+					delete(pod.Annotations, bpod.BoostAnnotationKey)
+					configSpec := specTemplate.DeepCopy()
+					configSpec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
+						ContainerPolicies: []autoscaling.ContainerPolicy{
+							{
+								MatchContainers: &autoscaling.MatchContainers{
+									Type: autoscaling.MatchContainersTypeComposite,
+									Include: []autoscaling.MatchContainersRule{
+										{Type: autoscaling.MatchContainersTypeRegexName, Value: "^container-"},
+									},
+									Exclude: []autoscaling.MatchContainersRule{
+										{Type: autoscaling.MatchContainersTypeExactName, Value: "container-two"},
+									},
+								},
+								PercentageIncrease: &autoscaling.PercentageIncrease{Value: 100},
+							},
+						},
+					}
+					boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("2"))
+					Expect(pod.Spec.Containers[1].Resources.Requests.Cpu().String()).To(Equal("1"))
+				})
+			})
+			When("container policies have POD matching rules", func() {
+				var (
+					boost cpuboost.StartupCPUBoost
+					pod   *corev1.Pod
+				)
+				BeforeEach(func() {
+					pod = podTemplate.DeepCopy()
+					delete(pod.Annotations, bpod.BoostAnnotationKey)
+					configSpec := specTemplate.DeepCopy()
+					configSpec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
+						ContainerPolicies: []autoscaling.ContainerPolicy{
+							{
+								MatchContainers: &autoscaling.MatchContainers{
+									Type:  autoscaling.MatchContainersTypeRegexName,
+									Value: "^container-",
+								},
+								MatchPod: &autoscaling.MatchPod{
+									Selector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"tier": "critical"},
+									},
+									PriorityClassNames: []string{"high-priority"},
+								},
+								PercentageIncrease: &autoscaling.PercentageIncrease{Value: 200},
+							},
+							{
+								MatchContainers: &autoscaling.MatchContainers{
+									Type:  autoscaling.MatchContainersTypeRegexName,
+									Value: "^container-",
+								},
+								PercentageIncrease: &autoscaling.PercentageIncrease{Value: 100},
+							},
+						},
+					}
+					var err error
+					boost, err = cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
+					Expect(err).NotTo(HaveOccurred())
+				})
+				It("applies the first policy when POD matches", func() {
+					pod.Labels["tier"] = "critical"
+					pod.Spec.PriorityClassName = "high-priority"
+
+					_, err := boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("3"))
+				})
+				It("applies the next policy when POD does not match", func() {
+					pod.Labels["tier"] = "critical"
+
+					_, err := boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().String()).To(Equal("2"))
+				})
+			})
+			When("some containers do not meet requirements for resource increase", func() {
+				It("records skipped containers in the annotation", func() {
+					pod := podTemplate.DeepCopy()
+					delete(pod.Annotations, bpod.BoostAnnotationKey)
+					pod.Spec.Containers[1].ResizePolicy = []corev1.ContainerResizePolicy{
+						{
+							ResourceName:  corev1.ResourceCPU,
+							RestartPolicy: corev1.RestartContainer,
+						},
+					}
+
+					configSpec := specTemplate.DeepCopy()
+					configSpec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
+						ContainerPolicies: []autoscaling.ContainerPolicy{
+							{
+								MatchContainers: &autoscaling.MatchContainers{
+									Type:  autoscaling.MatchContainersTypeRegexName,
+									Value: "^container-.*$",
+								},
+								PercentageIncrease: &autoscaling.PercentageIncrease{Value: 100},
+							},
+						},
+					}
+					boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, config)
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
+
+					Expect(err).NotTo(HaveOccurred())
+					annot, err := bpod.BoostAnnotationFromPod(pod)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(annot.InitCPURequests).To(HaveKey("container-one"))
+					Expect(annot.SkippedContainers).To(Equal(map[string]string{
+						"container-two": cpuboost.EventReasonSkippedRestartPolicy,
+					}))
 				})
 			})
 			When("container meets all requirements for resource increase", func() {
@@ -611,10 +2223,10 @@ var _ = Describe("StartupCPUBoost", func() {
 
 						configVal := *config
 						configVal.RemoveLimitsEnabled = false
-						boost, err := cpuboost.NewStartupCPUBoost(configSpec, &configVal)
+						boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, &configVal)
 						Expect(err).NotTo(HaveOccurred())
 
-						err = boost.ApplyResourcePolicy(context.Background(), pod)
+						_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
 
 						Expect(err).NotTo(HaveOccurred())
 						Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(2000)))
@@ -627,6 +2239,13 @@ var _ = Describe("StartupCPUBoost", func() {
 						Expect(annot.State).To(Equal(bpod.BoostStateActive))
 						Expect(annot.InitCPURequests["container-one"]).To(Equal("1"))
 						Expect(annot.InitCPULimits["container-one"]).To(Equal("2"))
+						Expect(annot.BoostedCPURequests["container-one"]).To(Equal("2"))
+						Expect(annot.BoostedCPULimits["container-one"]).To(Equal("4"))
+						Expect(annot.Version).To(Equal(bpod.BoostAnnotationVersion))
+						Expect(annot.BoostName).To(Equal(configSpec.Name))
+						Expect(annot.ResourcePolicies["container-one"]).To(Equal(resource.PercentageContainerPolicyName))
+						Expect(annot.Transitions).To(HaveLen(1))
+						Expect(annot.Transitions[0].Reason).To(Equal(bpod.BoostReasonBoosted))
 					})
 				})
 				Context("with regex container match policy", func() {
@@ -652,10 +2271,10 @@ var _ = Describe("StartupCPUBoost", func() {
 
 						configVal := *config
 						configVal.RemoveLimitsEnabled = false
-						boost, err := cpuboost.NewStartupCPUBoost(configSpec, &configVal)
+						boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, &configVal)
 						Expect(err).NotTo(HaveOccurred())
 
-						err = boost.ApplyResourcePolicy(context.Background(), pod)
+						_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
 
 						Expect(err).NotTo(HaveOccurred())
 						Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(2000)))
@@ -676,10 +2295,10 @@ var _ = Describe("StartupCPUBoost", func() {
 
 							configVal := *config
 							configVal.RemoveLimitsEnabled = true
-							boost, err := cpuboost.NewStartupCPUBoost(configSpec, &configVal)
+							boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, &configVal)
 							Expect(err).NotTo(HaveOccurred())
 
-							err = boost.ApplyResourcePolicy(context.Background(), pod)
+							_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
 
 							Expect(err).NotTo(HaveOccurred())
 							Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(2000)))
@@ -692,6 +2311,8 @@ var _ = Describe("StartupCPUBoost", func() {
 							Expect(annot.State).To(Equal(bpod.BoostStateActive))
 							Expect(annot.InitCPURequests["container-one"]).To(Equal("1"))
 							Expect(annot.InitCPULimits["container-one"]).To(Equal("2"))
+							Expect(annot.BoostedCPURequests["container-one"]).To(Equal("2"))
+							Expect(annot.BoostedCPULimits).NotTo(HaveKey("container-one"))
 						})
 					})
 					When("POD is Guaranteed (limits removal is skipped)", func() {
@@ -709,10 +2330,10 @@ var _ = Describe("StartupCPUBoost", func() {
 
 							configVal := *config
 							configVal.RemoveLimitsEnabled = true
-							boost, err := cpuboost.NewStartupCPUBoost(configSpec, &configVal)
+							boost, err := cpuboost.NewStartupCPUBoost(context.Background(), configSpec, &configVal)
 							Expect(err).NotTo(HaveOccurred())
 
-							err = boost.ApplyResourcePolicy(context.Background(), pod)
+							_, err = boost.ApplyResourcePolicy(context.Background(), pod, bpod.BoostOverrides{})
 
 							Expect(err).NotTo(HaveOccurred())
 							Expect(pod.Spec.Containers[0].Resources.Requests.Cpu().MilliValue()).To(Equal(int64(2000)))
@@ -733,6 +2354,61 @@ var _ = Describe("StartupCPUBoost", func() {
 	})
 })
 
+// applyPatch applies the merge patch on a given POD, as it is done with the
+// API server response
+func applyPatch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	patchData, err := patch.Data(obj)
+	if err != nil {
+		return err
+	}
+	pod := obj.(*corev1.Pod)
+	podJSON, err := json.Marshal(pod)
+	if err != nil {
+		return err
+	}
+	patchedJSON, err := jsonpatch.MergePatch(podJSON, patchData)
+	if err != nil {
+		return err
+	}
+	*pod = corev1.Pod{}
+	return json.Unmarshal(patchedJSON, pod)
+}
+
+func revertApplyConfig(pod *corev1.Pod, delta bool) *corev1ac.PodApplyConfiguration {
+	GinkgoHelper()
+	applyConfig, err := bpod.RevertBoostResourcesApplyConfiguration(pod, delta)
+	Expect(err).NotTo(HaveOccurred())
+	return applyConfig
+}
+
+func expectSkippedContainersRecorded(pod *corev1.Pod, skipped map[string]string) {
+	GinkgoHelper()
+	annot, err := bpod.BoostAnnotationFromPod(pod)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(annot.HasInitCPUResources()).To(BeFalse())
+	Expect(annot.SkippedContainers).To(Equal(skipped))
+}
+
+func fieldManagerConflictError(podName string) error {
+	return apierrors.NewApplyConflict([]metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldManagerConflict,
+		Message: `conflict with "kubectl-create" using v1`,
+		Field:   `.spec.containers[name="` + containerOneName + `"].resources.requests.cpu`,
+	}}, "Apply failed with 1 conflict on pod "+podName)
+}
+
+func recordedEvents(recorder *events.FakeRecorder) []string {
+	var recorded []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			recorded = append(recorded, event)
+		default:
+			return recorded
+		}
+	}
+}
+
 func setContainerResource(pod *corev1.Pod, containerIdx int, res corev1.ResourceName, req, lim string) {
 	if pod.Spec.Containers[containerIdx].Resources.Requests == nil {
 		pod.Spec.Containers[containerIdx].Resources.Requests = make(corev1.ResourceList)
@@ -756,7 +2432,10 @@ func setContainerPercentagePolicy(configSpec *autoscaling.StartupCPUBoost, conta
 	configSpec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
 		ContainerPolicies: []autoscaling.ContainerPolicy{
 			{
-				ContainerName:      containerName,
+				MatchContainers: &autoscaling.MatchContainers{
+					Type:  autoscaling.MatchContainersTypeExactName,
+					Value: containerName,
+				},
 				PercentageIncrease: &autoscaling.PercentageIncrease{Value: percentage},
 			},
 		},
@@ -767,7 +2446,10 @@ func setContainerFixedPolicy(configSpec *autoscaling.StartupCPUBoost, containerN
 	configSpec.Spec.ResourcePolicy = autoscaling.ResourcePolicy{
 		ContainerPolicies: []autoscaling.ContainerPolicy{
 			{
-				ContainerName: containerName,
+				MatchContainers: &autoscaling.MatchContainers{
+					Type:  autoscaling.MatchContainersTypeExactName,
+					Value: containerName,
+				},
 				FixedResources: &autoscaling.FixedResources{
 					Requests: apiResource.MustParse(req),
 					Limits:   apiResource.MustParse(lim),
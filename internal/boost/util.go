@@ -64,6 +64,16 @@ func (o *namespacedObjects[T]) ListAll() []T {
 	return result
 }
 
+func (o *namespacedObjects[T]) Namespaces() []string {
+	result := make([]string, 0, len(o.objects))
+	for namespace, namespaceObjects := range o.objects {
+		if len(namespaceObjects) > 0 {
+			result = append(result, namespace)
+		}
+	}
+	return result
+}
+
 func (o *namespacedObjects[T]) Delete(name, namespace string) {
 	namespaceObjects, ok := o.objects[namespace]
 	if !ok {
@@ -74,5 +74,8 @@ var _ = Describe("Config", func() {
 		It("has valid ValidatingWebhookName", func() {
 			Expect(cfg.ValidatingWebhookName).To(Equal(config.ValidatingWebhookNameDefault))
 		})
+		It("has valid WebhookNamespaceScoping", func() {
+			Expect(cfg.WebhookNamespaceScoping).To(Equal(config.WebhookNamespaceScopingDefault))
+		})
 	})
 })
@@ -21,21 +21,22 @@ import (
 )
 
 const (
-	PodNamespaceEnvVar           = "POD_NAMESPACE"
-	MgrCheckIntervalSecEnvVar    = "MGR_CHECK_INTERVAL"
-	LeaderElectionEnvVar         = "LEADER_ELECTION"
-	MetricsProbeBindAddrEnvVar   = "METRICS_PROBE_BIND_ADDR"
-	HealthProbeBindAddrEnvVar    = "HEALTH_PROBE_BIND_ADDR"
-	SecureMetricsEnvVar          = "SECURE_METRICS"
-	ZapLogLevelEnvVar            = "ZAP_LOG_LEVEL"
-	ZapDevelopmentEnvVar         = "ZAP_DEVELOPMENT"
-	HTTP2EnvVar                  = "HTTP2"
-	RemoveLimitsEnvVar           = "REMOVE_LIMITS"
-	ValidateFeatureEnabledEnvVar = "VALIDATE_FEATURE_ENABLED"
-	WebhookServiceNameEnvVar     = "WEBHOOK_SERVICE_NAME"
-	WebhookSecretNameEnvVar      = "WEBHOOK_SECRET_NAME"
-	MutatingWebhookNameEnvVar    = "MUTATING_WEBHOOK_NAME"
-	ValidatingWebhookNameEnvVar  = "VALIDATING_WEBHOOK_NAME"
+	PodNamespaceEnvVar            = "POD_NAMESPACE"
+	MgrCheckIntervalSecEnvVar     = "MGR_CHECK_INTERVAL"
+	LeaderElectionEnvVar          = "LEADER_ELECTION"
+	MetricsProbeBindAddrEnvVar    = "METRICS_PROBE_BIND_ADDR"
+	HealthProbeBindAddrEnvVar     = "HEALTH_PROBE_BIND_ADDR"
+	SecureMetricsEnvVar           = "SECURE_METRICS"
+	ZapLogLevelEnvVar             = "ZAP_LOG_LEVEL"
+	ZapDevelopmentEnvVar          = "ZAP_DEVELOPMENT"
+	HTTP2EnvVar                   = "HTTP2"
+	RemoveLimitsEnvVar            = "REMOVE_LIMITS"
+	ValidateFeatureEnabledEnvVar  = "VALIDATE_FEATURE_ENABLED"
+	WebhookServiceNameEnvVar      = "WEBHOOK_SERVICE_NAME"
+	WebhookSecretNameEnvVar       = "WEBHOOK_SECRET_NAME"
+	MutatingWebhookNameEnvVar     = "MUTATING_WEBHOOK_NAME"
+	ValidatingWebhookNameEnvVar   = "VALIDATING_WEBHOOK_NAME"
+	WebhookNamespaceScopingEnvVar = "WEBHOOK_NAMESPACE_SCOPING"
 )
 
 type LookupEnvFunc func(key string) (string, bool)
@@ -69,6 +70,7 @@ func (p *EnvConfigProvider) LoadConfig() (*Config, error) {
 	p.loadWebhookSecretName(&config)
 	p.loadMutatingWebhookName(&config)
 	p.loadValidatingWebhookName(&config)
+	errs = p.loadWebhookNamespaceScoping(&config, errs)
 	var err error
 	if len(errs) > 0 {
 		err = errors.Join(errs...)
@@ -205,3 +207,15 @@ func (p *EnvConfigProvider) loadValidatingWebhookName(config *Config) {
 		config.ValidatingWebhookName = v
 	}
 }
+
+func (p *EnvConfigProvider) loadWebhookNamespaceScoping(config *Config, curErrs []error) (errs []error) {
+	errs = curErrs
+	if v, ok := p.lookupFunc(WebhookNamespaceScopingEnvVar); ok {
+		boolVal, err := strconv.ParseBool(v)
+		config.WebhookNamespaceScoping = boolVal
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s value is not a bool: %s", WebhookNamespaceScopingEnvVar, err))
+		}
+	}
+	return
+}
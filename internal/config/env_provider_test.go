@@ -179,5 +179,21 @@ var _ = Describe("EnvProvider", func() {
 				Expect(cfg.ValidatingWebhookName).To(Equal("custom-validating-webhook"))
 			})
 		})
+		When("webhookNamespaceScoping variable is set", func() {
+			BeforeEach(func() {
+				lookupFuncMap[config.WebhookNamespaceScopingEnvVar] = "true"
+			})
+			It("has valid WebhookNamespaceScoping", func() {
+				Expect(cfg.WebhookNamespaceScoping).To(BeTrue())
+			})
+		})
+		When("webhookNamespaceScoping variable is not set and earlier variable is invalid", func() {
+			BeforeEach(func() {
+				lookupFuncMap[config.ValidateFeatureEnabledEnvVar] = "not-a-bool"
+			})
+			It("errors", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 })
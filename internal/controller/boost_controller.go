@@ -17,32 +17,61 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/go-logr/logr"
-	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	"github.com/google/kube-startup-cpu-boost/internal/boost"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
-	BoostActiveConditionTrueReason   = "Ready"
-	BoostActiveConditionTrueMessage  = "Can boost new containers"
-	BoostActiveConditionFalseReason  = "NotFound"
-	BoostActiveConditionFalseMessage = "StartupCPUBoost not found"
-	WantedServerVersionForNewRevert  = "v1.32.0"
+	BoostActiveConditionType                 = "Active"
+	BoostActiveConditionTrueReason           = "Ready"
+	BoostActiveConditionTrueMessage          = "Can boost new containers"
+	BoostActiveConditionFalseReason          = "NotFound"
+	BoostActiveConditionFalseMessage         = "StartupCPUBoost not found"
+	BoostActiveConditionSuspendedReason      = "Suspended"
+	BoostActiveConditionSuspendedMessage     = "StartupCPUBoost is suspended, new containers are not boosted"
+	BoostSpecValidConditionType              = "SpecValid"
+	BoostSpecValidConditionTrueReason        = "Valid"
+	BoostSpecValidConditionTrueMessage       = "StartupCPUBoost spec is valid"
+	BoostSpecValidConditionFalseReason       = "InvalidSpec"
+	BoostDegradedConditionType               = "Degraded"
+	BoostDegradedConditionTrueReason         = "RevertFailed"
+	BoostDegradedConditionFalseReason        = "AsExpected"
+	BoostDegradedConditionFalseMessage       = "CPU resources are reverted successfully"
+	BoostConflictingConditionType            = "Conflicting"
+	BoostConflictingConditionTrueReason      = "OverlappingSelector"
+	BoostConflictingConditionFalseReason     = "NoOverlap"
+	BoostConflictingConditionFalseMessage    = "Selector does not overlap with other StartupCPUBoosts"
+	BoostProfileResolvedConditionType        = "ProfileResolved"
+	BoostProfileResolvedConditionTrueReason  = "Resolved"
+	BoostProfileResolvedConditionTrueMessage = "Referenced profile is resolved"
+	BoostProfileResolvedConditionFalseReason = "ResolutionFailed"
+	MaxActivePodsInStatus                    = 20
+	ConflictingBoostsBufferSize              = 100
+	WantedServerVersionForNewRevert          = "v1.32.0"
 )
 
 // StartupCPUBoostReconciler reconciles a StartupCPUBoost object
@@ -51,9 +80,15 @@ type StartupCPUBoostReconciler struct {
 	Scheme                   *runtime.Scheme
 	Log                      logr.Logger
 	Manager                  boost.Manager
+	EventRecorder            events.EventRecorder
 	LegacyRevertMode         bool
 	PodLevelResourcesEnabled bool
 	RemoveLimitsEnabled      bool
+	OwnerResolver            boost.OwnerResolver
+	ProfileResolver          boost.ProfileResolver
+	// ConflictingBoosts receives the StartupCPUBoosts to reconcile as the boost overlapping
+	// with them was added, updated or deleted. It is created by SetupWithManager when nil.
+	ConflictingBoosts chan event.GenericEvent
 }
 
 //+kubebuilder:rbac:groups=autoscaling.x-k8s.io,resources=startupcpuboosts,verbs=get;list;watch;create;update;patch;delete
@@ -61,6 +96,7 @@ type StartupCPUBoostReconciler struct {
 //+kubebuilder:rbac:groups=autoscaling.x-k8s.io,resources=startupcpuboosts/finalizers,verbs=update
 //+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;update;patch;watch
 //+kubebuilder:rbac:groups="",resources=pods/resize,verbs=patch
+//+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -73,8 +109,9 @@ func (r *StartupCPUBoostReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 	log := r.Log.WithValues("name", boostObj.Name, "namespace", boostObj.Namespace)
 	newBoostObj := boostObj.DeepCopy()
+	newBoostObj.Status.ObservedGeneration = boostObj.Generation
 	activeCondition := metav1.Condition{
-		Type:    "Active",
+		Type:    BoostActiveConditionType,
 		Status:  metav1.ConditionFalse,
 		Reason:  BoostActiveConditionFalseReason,
 		Message: BoostActiveConditionFalseMessage,
@@ -86,10 +123,34 @@ func (r *StartupCPUBoostReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		activeCondition.Status = metav1.ConditionTrue
 		activeCondition.Reason = BoostActiveConditionTrueReason
 		activeCondition.Message = BoostActiveConditionTrueMessage
+		if boostObj.Spec.Suspend {
+			activeCondition.Status = metav1.ConditionFalse
+			activeCondition.Reason = BoostActiveConditionSuspendedReason
+			activeCondition.Message = BoostActiveConditionSuspendedMessage
+		}
 		newBoostObj.Status.ActiveContainerBoosts = int32(stats.ActiveContainerBoosts)
 		newBoostObj.Status.TotalContainerBoosts = int32(stats.TotalContainerBoosts)
+		newBoostObj.Status.DeferredContainerBoosts = int32(stats.DeferredContainerBoosts)
+		newBoostObj.Status.InfeasibleContainerBoosts = int32(stats.InfeasibleContainerBoosts)
+		newBoostObj.Status.SkippedContainerBoosts = skippedContainerBoostsStatus(stats)
+		newBoostObj.Status.ActivePods = activePodsStatus(stats)
+		newBoostObj.Status.ExtraCPU = cpuStatus(stats.ExtraCPU)
+		newBoostObj.Status.ExtraCPUCoreSeconds = int64(stats.ExtraCPUCoreSeconds)
+		newBoostObj.Status.DryRunContainerBoosts = int32(stats.DryRunContainerBoosts)
+		newBoostObj.Status.DryRunExtraCPU = cpuStatus(stats.DryRunExtraCPU)
+		setCondition(newBoostObj, degradedCondition(stats))
+		setCondition(newBoostObj, r.conflictingCondition(ctx, &boostObj))
+	} else {
+		meta.RemoveStatusCondition(&newBoostObj.Status.Conditions, BoostDegradedConditionType)
+		meta.RemoveStatusCondition(&newBoostObj.Status.Conditions, BoostConflictingConditionType)
+	}
+	setCondition(newBoostObj, activeCondition)
+	setCondition(newBoostObj, specValidCondition(&boostObj))
+	if boostObj.Spec.ProfileRef != nil {
+		setCondition(newBoostObj, r.profileResolvedCondition(ctx, &boostObj))
+	} else {
+		meta.RemoveStatusCondition(&newBoostObj.Status.Conditions, BoostProfileResolvedConditionType)
 	}
-	meta.SetStatusCondition(&newBoostObj.Status.Conditions, activeCondition)
 	if !equality.Semantic.DeepEqual(newBoostObj.Status, boostObj.Status) {
 		log.V(5).Info("updating boost status")
 		err = r.Client.Status().Update(ctx, newBoostObj)
@@ -105,6 +166,135 @@ func (r *StartupCPUBoostReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	return ctrl.Result{}, nil
 }
 
+// specValidCondition returns the SpecValid condition of a given StartupCPUBoost
+func specValidCondition(boostObj *autoscaling.StartupCPUBoost) metav1.Condition {
+	if err := boost.ValidateSpec(boostObj); err != nil {
+		return metav1.Condition{
+			Type:    BoostSpecValidConditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  BoostSpecValidConditionFalseReason,
+			Message: err.Error(),
+		}
+	}
+	return metav1.Condition{
+		Type:    BoostSpecValidConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  BoostSpecValidConditionTrueReason,
+		Message: BoostSpecValidConditionTrueMessage,
+	}
+}
+
+// degradedCondition returns the Degraded condition basing on the boost revert failures
+func degradedCondition(stats boost.StartupCPUBoostStats) metav1.Condition {
+	if stats.FailedReverts > 0 {
+		return metav1.Condition{
+			Type:   BoostDegradedConditionType,
+			Status: metav1.ConditionTrue,
+			Reason: BoostDegradedConditionTrueReason,
+			Message: fmt.Sprintf("Failed to revert CPU resources of %d PODs: %s",
+				stats.FailedReverts, stats.LastRevertError),
+		}
+	}
+	return metav1.Condition{
+		Type:    BoostDegradedConditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  BoostDegradedConditionFalseReason,
+		Message: BoostDegradedConditionFalseMessage,
+	}
+}
+
+// conflictingCondition returns the Conflicting condition basing on the boosts which
+// selectors overlap with a given StartupCPUBoost
+func (r *StartupCPUBoostReconciler) conflictingCondition(ctx context.Context,
+	boostObj *autoscaling.StartupCPUBoost) metav1.Condition {
+	if conflicting := r.Manager.ConflictingCPUBoosts(ctx, boostObj.Name, boostObj.Namespace); len(conflicting) > 0 {
+		return metav1.Condition{
+			Type:    BoostConflictingConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  BoostConflictingConditionTrueReason,
+			Message: "Selector overlaps with StartupCPUBoosts: " + strings.Join(conflicting, ", "),
+		}
+	}
+	return metav1.Condition{
+		Type:    BoostConflictingConditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  BoostConflictingConditionFalseReason,
+		Message: BoostConflictingConditionFalseMessage,
+	}
+}
+
+// profileResolvedCondition returns the ProfileResolved condition of a given StartupCPUBoost
+// basing on the resolution of the profile it references
+func (r *StartupCPUBoostReconciler) profileResolvedCondition(ctx context.Context,
+	boostObj *autoscaling.StartupCPUBoost) metav1.Condition {
+	err := boost.ErrNilProfileResolver
+	if r.ProfileResolver != nil {
+		_, err = boost.ResolveProfile(ctx, r.ProfileResolver, boostObj)
+	}
+	if err != nil {
+		return metav1.Condition{
+			Type:    BoostProfileResolvedConditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  BoostProfileResolvedConditionFalseReason,
+			Message: err.Error(),
+		}
+	}
+	return metav1.Condition{
+		Type:    BoostProfileResolvedConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  BoostProfileResolvedConditionTrueReason,
+		Message: BoostProfileResolvedConditionTrueMessage,
+	}
+}
+
+// setCondition sets the condition on a given StartupCPUBoost status with the
+// observed generation of the StartupCPUBoost
+func setCondition(boostObj *autoscaling.StartupCPUBoost, condition metav1.Condition) {
+	condition.ObservedGeneration = boostObj.Generation
+	meta.SetStatusCondition(&boostObj.Status.Conditions, condition)
+}
+
+// skippedContainerBoostsStatus maps the skipped container boosts stats to the
+// status list ordered by the skip reason
+func skippedContainerBoostsStatus(stats boost.StartupCPUBoostStats) []autoscaling.SkippedContainerBoosts {
+	var result []autoscaling.SkippedContainerBoosts
+	for _, reason := range slices.Sorted(maps.Keys(stats.SkippedContainerBoosts)) {
+		result = append(result, autoscaling.SkippedContainerBoosts{
+			Reason: reason,
+			Count:  int32(stats.SkippedContainerBoosts[reason]),
+		})
+	}
+	return result
+}
+
+// activePodsStatus maps the active PODs stats to the status list bounded
+// to MaxActivePodsInStatus items
+func activePodsStatus(stats boost.StartupCPUBoostStats) []autoscaling.ActivePodBoost {
+	var result []autoscaling.ActivePodBoost
+	for _, pod := range stats.ActivePods[:min(len(stats.ActivePods), MaxActivePodsInStatus)] {
+		activePod := autoscaling.ActivePodBoost{
+			Name:      pod.Name,
+			BoostTime: metav1.NewTime(pod.BoostTime).Rfc3339Copy(),
+		}
+		if pod.ExpectedRevertTime != nil {
+			revertTime := metav1.NewTime(*pod.ExpectedRevertTime).Rfc3339Copy()
+			activePod.ExpectedRevertTime = &revertTime
+		}
+		result = append(result, activePod)
+	}
+	return result
+}
+
+// cpuStatus maps the CPU stats to the status quantity. Nil is returned when
+// no CPU is accounted.
+func cpuStatus(value apiResource.Quantity) *apiResource.Quantity {
+	if value.IsZero() {
+		return nil
+	}
+	cpu := value.DeepCopy()
+	return &cpu
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *StartupCPUBoostReconciler) SetupWithManager(mgr ctrl.Manager,
 	serverVersion string) error {
@@ -113,6 +303,9 @@ func (r *StartupCPUBoostReconciler) SetupWithManager(mgr ctrl.Manager,
 	if err != nil {
 		return err
 	}
+	if r.ConflictingBoosts == nil {
+		r.ConflictingBoosts = make(chan event.GenericEvent, ConflictingBoostsBufferSize)
+	}
 	r.LegacyRevertMode = ShouldUseLegacyRevertMode(serverVersion)
 	ctrl.Log.WithName("boost-controller-setup").WithValues("legacyRevertMode", r.LegacyRevertMode).
 		V(5).Info("setting legacy revert mode")
@@ -121,6 +314,11 @@ func (r *StartupCPUBoostReconciler) SetupWithManager(mgr ctrl.Manager,
 		Watches(&corev1.Pod{},
 			boostPodHandler,
 			builder.WithPredicates(lsPredicate)).
+		Watches(&autoscaling.StartupCPUBoostProfile{},
+			handler.EnqueueRequestsFromMapFunc(r.profileBoostRequests)).
+		Watches(&autoscaling.ClusterStartupCPUBoostProfile{},
+			handler.EnqueueRequestsFromMapFunc(r.profileBoostRequests)).
+		WatchesRawSource(source.Channel(r.ConflictingBoosts, &handler.EnqueueRequestForObject{})).
 		WithEventFilter(r).
 		Complete(r)
 }
@@ -135,19 +333,30 @@ func (r *StartupCPUBoostReconciler) Create(e event.CreateEvent) bool {
 	ctx := ctrl.LoggerInto(context.Background(), log)
 	bostConfig := &boost.StartupCPUBoostConfig{
 		Client:                   r.Client,
+		EventRecorder:            r.EventRecorder,
 		LegacyRevertMode:         r.LegacyRevertMode,
 		PodLevelResourcesEnabled: r.PodLevelResourcesEnabled,
 		RemoveLimitsEnabled:      r.RemoveLimitsEnabled,
+		OwnerResolver:            r.OwnerResolver,
+		ProfileResolver:          r.ProfileResolver,
 	}
-	cpuBoost, err := boost.NewStartupCPUBoost(boostObj, bostConfig)
+	profileCtx, cancel := context.WithTimeout(ctx, boost.ProfileResolutionTimeout)
+	defer cancel()
+	cpuBoost, err := boost.NewStartupCPUBoost(profileCtx, boostObj, bostConfig)
 	if err != nil {
 		log.Error(err, "boost creation error")
+		return true
+	}
+	if err := cpuBoost.ProfileError(); err != nil {
+		log.Error(err, "boost profile resolution error, new PODs are not boosted until it is resolved")
 	}
 	if err := r.Manager.AddRegularCPUBoost(ctx, cpuBoost); err != nil {
 		if !errors.Is(err, boost.ErrStartupCPUBoostAlreadyExists) {
 			log.Error(err, "boost registration error")
 		}
+		return true
 	}
+	r.enqueueConflictingBoosts(boostObj.Namespace, r.conflictingBoosts(ctx, boostObj))
 	return true
 }
 
@@ -159,7 +368,9 @@ func (r *StartupCPUBoostReconciler) Delete(e event.DeleteEvent) bool {
 	log := r.Log.WithValues("name", boostObj.Name, "namespace", boostObj.Namespace)
 	log.V(5).Info("handling boost delete event")
 	ctx := ctrl.LoggerInto(context.Background(), log)
+	conflicting := r.conflictingBoosts(ctx, boostObj)
 	r.Manager.DeleteRegularCPUBoost(ctx, boostObj.Namespace, boostObj.Name)
+	r.enqueueConflictingBoosts(boostObj.Namespace, conflicting)
 	return true
 }
 
@@ -171,9 +382,12 @@ func (r *StartupCPUBoostReconciler) Update(e event.UpdateEvent) bool {
 	log := r.Log.WithValues("name", boostObj.Name, "namespace", boostObj.Namespace)
 	log.V(5).Info("handling boost update event")
 	ctx := ctrl.LoggerInto(context.Background(), log)
+	conflicting := r.conflictingBoosts(ctx, boostObj)
 	if err := r.Manager.UpdateRegularCPUBoost(ctx, boostObj); err != nil {
 		log.Error(err, "boost update error")
 	}
+	conflicting = append(conflicting, r.conflictingBoosts(ctx, boostObj)...)
+	r.enqueueConflictingBoosts(boostObj.Namespace, conflicting)
 	return true
 }
 
@@ -183,6 +397,28 @@ func (r *StartupCPUBoostReconciler) Generic(e event.GenericEvent) bool {
 	return true
 }
 
+// conflictingBoosts returns the names of the boosts overlapping with a given StartupCPUBoost.
+// Nothing is returned when the boosts cannot be enqueued.
+func (r *StartupCPUBoostReconciler) conflictingBoosts(ctx context.Context,
+	boostObj *autoscaling.StartupCPUBoost) []string {
+	if r.ConflictingBoosts == nil {
+		return nil
+	}
+	return r.Manager.ConflictingCPUBoosts(ctx, boostObj.Name, boostObj.Namespace)
+}
+
+// enqueueConflictingBoosts enqueues the StartupCPUBoosts with given names in a given
+// namespace, so their Conflicting condition is updated
+func (r *StartupCPUBoostReconciler) enqueueConflictingBoosts(namespace string, names []string) {
+	for _, name := range names {
+		r.ConflictingBoosts <- event.GenericEvent{
+			Object: &autoscaling.StartupCPUBoost{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			},
+		}
+	}
+}
+
 // ShouldUseLegacyRevertMode determines if legacy resource revert mode should be used
 // basing on server version
 func ShouldUseLegacyRevertMode(serverVersion string) (legacyMode bool) {
@@ -16,18 +16,23 @@ package controller_test
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
-	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	"github.com/google/kube-startup-cpu-boost/internal/boost"
 	"github.com/google/kube-startup-cpu-boost/internal/controller"
 	"github.com/google/kube-startup-cpu-boost/internal/mock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -131,15 +136,43 @@ var _ = Describe("BoostController", func() {
 					Reason:  controller.BoostActiveConditionTrueReason,
 					Message: controller.BoostActiveConditionTrueMessage,
 				}
+				specValidConditionTrue = metav1.Condition{
+					Type:    controller.BoostSpecValidConditionType,
+					Status:  metav1.ConditionTrue,
+					Reason:  controller.BoostSpecValidConditionTrueReason,
+					Message: controller.BoostSpecValidConditionTrueMessage,
+				}
+				degradedConditionFalse = metav1.Condition{
+					Type:    controller.BoostDegradedConditionType,
+					Status:  metav1.ConditionFalse,
+					Reason:  controller.BoostDegradedConditionFalseReason,
+					Message: controller.BoostDegradedConditionFalseMessage,
+				}
+				conflictingConditionFalse = metav1.Condition{
+					Type:    controller.BoostConflictingConditionType,
+					Status:  metav1.ConditionFalse,
+					Reason:  controller.BoostConflictingConditionFalseReason,
+					Message: controller.BoostConflictingConditionFalseMessage,
+				}
+				stats       boost.StartupCPUBoostStats
+				conflicting []string
 			)
 			BeforeEach(func() {
-				stats := boost.StartupCPUBoostStats{
+				stats = boost.StartupCPUBoostStats{
 					TotalContainerBoosts:  totalContainerBoosts,
 					ActiveContainerBoosts: activeContainerBoosts,
 				}
+				conflicting = nil
 				mockManager.EXPECT().GetRegularCPUBoost(gomock.Any(), gomock.Eq(name),
 					gomock.Eq(namespace)).Times(1).Return(mockBoost, true)
-				mockBoost.EXPECT().Stats().Times(1).Return(stats)
+				mockBoost.EXPECT().Stats().Times(1).DoAndReturn(func() boost.StartupCPUBoostStats {
+					return stats
+				})
+				mockManager.EXPECT().ConflictingCPUBoosts(gomock.Any(), gomock.Eq(name),
+					gomock.Eq(namespace)).Times(1).DoAndReturn(func(ctx context.Context, name,
+					namespace string) []string {
+					return conflicting
+				})
 			})
 			When("there existing status is up to date", func() {
 				BeforeEach(func() {
@@ -152,6 +185,9 @@ var _ = Describe("BoostController", func() {
 							boostObj.Name = name
 							boostObj.Namespace = namespace
 							meta.SetStatusCondition(&boostObj.Status.Conditions, activeConditionTrue)
+							meta.SetStatusCondition(&boostObj.Status.Conditions, specValidConditionTrue)
+							meta.SetStatusCondition(&boostObj.Status.Conditions, degradedConditionFalse)
+							meta.SetStatusCondition(&boostObj.Status.Conditions, conflictingConditionFalse)
 							boostObj.Status.TotalContainerBoosts = int32(totalContainerBoosts)
 							boostObj.Status.ActiveContainerBoosts = int32(activeContainerBoosts)
 							return nil
@@ -197,6 +233,263 @@ var _ = Describe("BoostController", func() {
 					Expect(result).To(Equal(ctrl.Result{}))
 				})
 			})
+			When("boost has failed reverts, skipped containers and conflicts", func() {
+				var (
+					updatedBoostObj *autoscaling.StartupCPUBoost
+					boostTime       = time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+					revertTime      = boostTime.Add(time.Minute)
+				)
+				BeforeEach(func() {
+					stats.FailedReverts = 2
+					stats.LastRevertError = "resize forbidden"
+					stats.SkippedContainerBoosts = map[string]int{"SkippedRestartPolicy": 1, "SkippedQoSChange": 3}
+					stats.ExtraCPU = apiResource.MustParse("1500m")
+					stats.ExtraCPUCoreSeconds = 90.7
+					stats.DryRunContainerBoosts = 4
+					stats.DryRunExtraCPU = apiResource.MustParse("2")
+					for i := 0; i < controller.MaxActivePodsInStatus+5; i++ {
+						stats.ActivePods = append(stats.ActivePods, boost.PodBoostStats{
+							Name:               fmt.Sprintf("pod-%02d", i),
+							BoostTime:          boostTime,
+							ExpectedRevertTime: &revertTime,
+						})
+					}
+					conflicting = []string{"boost-002", "boost-003"}
+					mockSubResClient := mock.NewMockSubResourceClient(mockCtrl)
+					mockSubResClient.EXPECT().Update(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, obj client.Object,
+							opts ...client.SubResourceUpdateOption) error {
+							updatedBoostObj = obj.(*autoscaling.StartupCPUBoost)
+							return nil
+						}).Times(1)
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(req.NamespacedName),
+						gomock.Any()).
+						Times(1).DoAndReturn(func(c context.Context, cc client.ObjectKey,
+						obj client.Object, opts ...client.GetOption) error {
+						boostObj := obj.(*autoscaling.StartupCPUBoost)
+						boostObj.Name = name
+						boostObj.Namespace = namespace
+						boostObj.Generation = 3
+						return nil
+					})
+					mockClient.EXPECT().Status().Return(mockSubResClient).Times(1)
+				})
+				It("does not error", func() {
+					Expect(err).To(BeNil())
+				})
+				It("sets the observed generation", func() {
+					Expect(updatedBoostObj.Status.ObservedGeneration).To(Equal(int64(3)))
+					for _, cond := range updatedBoostObj.Status.Conditions {
+						Expect(cond.ObservedGeneration).To(Equal(int64(3)))
+					}
+				})
+				It("sets the degraded condition", func() {
+					cond := meta.FindStatusCondition(updatedBoostObj.Status.Conditions,
+						controller.BoostDegradedConditionType)
+					Expect(cond).NotTo(BeNil())
+					Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+					Expect(cond.Reason).To(Equal(controller.BoostDegradedConditionTrueReason))
+					Expect(cond.Message).To(ContainSubstring("resize forbidden"))
+				})
+				It("sets the conflicting condition", func() {
+					cond := meta.FindStatusCondition(updatedBoostObj.Status.Conditions,
+						controller.BoostConflictingConditionType)
+					Expect(cond).NotTo(BeNil())
+					Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+					Expect(cond.Message).To(ContainSubstring("boost-002, boost-003"))
+				})
+				It("sets the skipped container boosts ordered by reason", func() {
+					Expect(updatedBoostObj.Status.SkippedContainerBoosts).To(Equal([]autoscaling.SkippedContainerBoosts{
+						{Reason: "SkippedQoSChange", Count: 3},
+						{Reason: "SkippedRestartPolicy", Count: 1},
+					}))
+				})
+				It("sets the bounded list of active pods", func() {
+					activePods := updatedBoostObj.Status.ActivePods
+					Expect(activePods).To(HaveLen(controller.MaxActivePodsInStatus))
+					Expect(activePods[0].Name).To(Equal("pod-00"))
+					Expect(activePods[0].BoostTime.Time).To(BeTemporally("==", boostTime))
+					Expect(activePods[0].ExpectedRevertTime.Time).To(BeTemporally("==", revertTime))
+				})
+				It("sets the extra CPU summary", func() {
+					Expect(updatedBoostObj.Status.ExtraCPU).NotTo(BeNil())
+					Expect(updatedBoostObj.Status.ExtraCPU.String()).To(Equal("1500m"))
+					Expect(updatedBoostObj.Status.ExtraCPUCoreSeconds).To(Equal(int64(90)))
+				})
+				It("sets the dry-run summary", func() {
+					Expect(updatedBoostObj.Status.DryRunContainerBoosts).To(Equal(int32(4)))
+					Expect(updatedBoostObj.Status.DryRunExtraCPU).NotTo(BeNil())
+					Expect(updatedBoostObj.Status.DryRunExtraCPU.String()).To(Equal("2"))
+				})
+			})
+			When("boost is suspended", func() {
+				var updatedBoostObj *autoscaling.StartupCPUBoost
+				BeforeEach(func() {
+					mockSubResClient := mock.NewMockSubResourceClient(mockCtrl)
+					mockSubResClient.EXPECT().Update(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, obj client.Object,
+							opts ...client.SubResourceUpdateOption) error {
+							updatedBoostObj = obj.(*autoscaling.StartupCPUBoost)
+							return nil
+						}).Times(1)
+					mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(req.NamespacedName),
+						gomock.Any()).
+						Times(1).DoAndReturn(func(c context.Context, cc client.ObjectKey,
+						obj client.Object, opts ...client.GetOption) error {
+						boostObj := obj.(*autoscaling.StartupCPUBoost)
+						boostObj.Name = name
+						boostObj.Namespace = namespace
+						boostObj.Spec.Suspend = true
+						return nil
+					})
+					mockClient.EXPECT().Status().Return(mockSubResClient).Times(1)
+				})
+				It("sets the suspended active condition and the stats", func() {
+					Expect(err).To(BeNil())
+					cond := meta.FindStatusCondition(updatedBoostObj.Status.Conditions,
+						controller.BoostActiveConditionType)
+					Expect(cond).NotTo(BeNil())
+					Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+					Expect(cond.Reason).To(Equal(controller.BoostActiveConditionSuspendedReason))
+					Expect(updatedBoostObj.Status.ActiveContainerBoosts).To(Equal(int32(activeContainerBoosts)))
+				})
+			})
+		})
+		When("boost is not registered in boost manager", func() {
+			var updatedBoostObj *autoscaling.StartupCPUBoost
+			BeforeEach(func() {
+				mockManager.EXPECT().GetRegularCPUBoost(gomock.Any(), gomock.Eq(name),
+					gomock.Eq(namespace)).Times(1).Return(nil, false)
+				mockSubResClient := mock.NewMockSubResourceClient(mockCtrl)
+				mockSubResClient.EXPECT().Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj client.Object,
+						opts ...client.SubResourceUpdateOption) error {
+						updatedBoostObj = obj.(*autoscaling.StartupCPUBoost)
+						return nil
+					}).Times(1)
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(req.NamespacedName),
+					gomock.Any()).
+					Times(1).DoAndReturn(func(c context.Context, cc client.ObjectKey,
+					obj client.Object, opts ...client.GetOption) error {
+					boostObj := obj.(*autoscaling.StartupCPUBoost)
+					boostObj.Name = name
+					boostObj.Namespace = namespace
+					boostObj.Spec.ResourcePolicy.ContainerPolicies = []autoscaling.ContainerPolicy{
+						{
+							MatchContainers: &autoscaling.MatchContainers{
+								Type:  autoscaling.MatchContainersTypeExactName,
+								Value: "container-one",
+							},
+						},
+					}
+					return nil
+				})
+				mockClient.EXPECT().Status().Return(mockSubResClient).Times(1)
+			})
+			It("sets the inactive and spec invalid conditions", func() {
+				Expect(err).To(BeNil())
+				active := meta.FindStatusCondition(updatedBoostObj.Status.Conditions, "Active")
+				Expect(active).NotTo(BeNil())
+				Expect(active.Status).To(Equal(metav1.ConditionFalse))
+				specValid := meta.FindStatusCondition(updatedBoostObj.Status.Conditions,
+					controller.BoostSpecValidConditionType)
+				Expect(specValid).NotTo(BeNil())
+				Expect(specValid.Status).To(Equal(metav1.ConditionFalse))
+				Expect(specValid.Reason).To(Equal(controller.BoostSpecValidConditionFalseReason))
+			})
+		})
+		When("boost references the profile", func() {
+			var (
+				updatedBoostObj *autoscaling.StartupCPUBoost
+				profileExists   bool
+			)
+			BeforeEach(func() {
+				profileExists = true
+				boostCtrl.ProfileResolver = boost.NewProfileResolver(mockClient)
+				mockManager.EXPECT().GetRegularCPUBoost(gomock.Any(), gomock.Eq(name),
+					gomock.Eq(namespace)).Times(1).Return(nil, false)
+				mockSubResClient := mock.NewMockSubResourceClient(mockCtrl)
+				mockSubResClient.EXPECT().Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj client.Object,
+						opts ...client.SubResourceUpdateOption) error {
+						updatedBoostObj = obj.(*autoscaling.StartupCPUBoost)
+						return nil
+					}).Times(1)
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(req.NamespacedName),
+					gomock.AssignableToTypeOf(&autoscaling.StartupCPUBoost{})).
+					Times(1).DoAndReturn(func(c context.Context, cc client.ObjectKey,
+					obj client.Object, opts ...client.GetOption) error {
+					boostObj := obj.(*autoscaling.StartupCPUBoost)
+					boostObj.Name = name
+					boostObj.Namespace = namespace
+					boostObj.Spec.ProfileRef = &autoscaling.ProfileReference{
+						Kind: autoscaling.ProfileKindCluster,
+						Name: "jvm",
+					}
+					return nil
+				})
+				mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(client.ObjectKey{Name: "jvm"}),
+					gomock.AssignableToTypeOf(&autoscaling.ClusterStartupCPUBoostProfile{})).
+					Times(1).DoAndReturn(func(c context.Context, cc client.ObjectKey,
+					obj client.Object, opts ...client.GetOption) error {
+					if !profileExists {
+						return apierrors.NewNotFound(schema.GroupResource{Resource: "clusterstartupcpuboostprofiles"}, "jvm")
+					}
+					return nil
+				})
+				mockClient.EXPECT().Status().Return(mockSubResClient).Times(1)
+			})
+			When("profile exists", func() {
+				It("sets the profile resolved condition", func() {
+					Expect(err).To(BeNil())
+					cond := meta.FindStatusCondition(updatedBoostObj.Status.Conditions,
+						controller.BoostProfileResolvedConditionType)
+					Expect(cond).NotTo(BeNil())
+					Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+					Expect(cond.Reason).To(Equal(controller.BoostProfileResolvedConditionTrueReason))
+				})
+			})
+			When("profile does not exist", func() {
+				BeforeEach(func() {
+					profileExists = false
+				})
+				It("sets the profile not resolved condition", func() {
+					Expect(err).To(BeNil())
+					cond := meta.FindStatusCondition(updatedBoostObj.Status.Conditions,
+						controller.BoostProfileResolvedConditionType)
+					Expect(cond).NotTo(BeNil())
+					Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+					Expect(cond.Reason).To(Equal(controller.BoostProfileResolvedConditionFalseReason))
+					Expect(cond.Message).To(ContainSubstring("not found"))
+				})
+			})
+		})
+	})
+	Describe("receives create event", func() {
+		var createEvent event.CreateEvent
+		BeforeEach(func() {
+			createEvent = event.CreateEvent{
+				Object: &autoscaling.StartupCPUBoost{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "boost-001",
+						Namespace: "demo",
+					},
+				},
+			}
+			boostCtrl.ConflictingBoosts = make(chan event.GenericEvent, 10)
+			mockManager.EXPECT().AddRegularCPUBoost(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			mockManager.EXPECT().ConflictingCPUBoosts(gomock.Any(), "boost-001", "demo").
+				Return([]string{"boost-002"}).Times(1)
+		})
+		JustBeforeEach(func() {
+			ok := boostCtrl.Create(createEvent)
+			Expect(ok).To(BeTrue())
+		})
+		It("enqueues the overlapping boosts", func() {
+			Expect(boostCtrl.ConflictingBoosts).To(HaveLen(1))
+			conflicting := <-boostCtrl.ConflictingBoosts
+			Expect(conflicting.Object.GetName()).To(Equal("boost-002"))
+			Expect(conflicting.Object.GetNamespace()).To(Equal("demo"))
 		})
 	})
 	Describe("receives update event", func() {
@@ -108,11 +108,15 @@ func (h *boostPodHandler) Update(ctx context.Context, e event.UpdateEvent,
 	}
 	log := h.log.WithValues("pod", pod.Name, "namespace", pod.Namespace)
 	log.V(5).Info("handling pod update")
+	eventType := bpod.PodEventTypeConditionChanged
 	if equality.Semantic.DeepEqual(pod.Status.Conditions, oldPod.Status.Conditions) {
-		log.V(5).Info("pod update skipped: conditions did not change")
-		return
+		if !bpod.ContainerCPUResourcesChanged(oldPod, pod) {
+			log.V(5).Info("pod update skipped: conditions and container resources did not change")
+			return
+		}
+		eventType = bpod.PodEventTypeResourcesChanged
 	}
-	boost, err := h.manager.HandlePodEvent(ctx, &bpod.PodEvent{Type: bpod.PodEventTypeConditionChanged, Pod: pod})
+	boost, err := h.manager.HandlePodEvent(ctx, &bpod.PodEvent{Type: eventType, Pod: pod})
 	if err != nil {
 		log.Error(err, "failed to handle pod update")
 		return
@@ -205,6 +205,29 @@ var _ = Describe("BoostPodHandler", func() {
 				})
 			})
 		})
+		When("Pod container status resources has changed", func() {
+			BeforeEach(func() {
+				oldPod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name:      "container-one",
+						Resources: podTemplate.Spec.Containers[0].Resources.DeepCopy(),
+					},
+				}
+				newPod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name:      "container-one",
+						Resources: &corev1.ResourceRequirements{},
+					},
+				}
+				mgrMockCall = mgrMock.EXPECT().HandlePodEvent(
+					gomock.Any(),
+					gomock.Eq(&bpod.PodEvent{Type: bpod.PodEventTypeResourcesChanged, Pod: newPod}),
+				).Return(nil, nil)
+			})
+			It("sends a valid call to the boost manager", func() {
+				mgrMockCall.Times(1)
+			})
+		})
 	})
 	Describe("Provides the POD label selector", func() {
 		var selector *metav1.LabelSelector
@@ -0,0 +1,115 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
+	"github.com/google/kube-startup-cpu-boost/internal/boost"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// StartupCPUBoostProfileReconciler refreshes the StartupCPUBoosts registered in the boost
+// manager when the StartupCPUBoostProfile or ClusterStartupCPUBoostProfile they reference
+// changes. The reconciler runs on every replica as the boost manager is not shared.
+type StartupCPUBoostProfileReconciler struct {
+	client.Client
+	Log     logr.Logger
+	Manager boost.Manager
+}
+
+// Reconcile updates the boosts referencing the profile from the request. The request
+// without namespace refers to the ClusterStartupCPUBoostProfile. The boost update errors
+// are returned so the request is requeued.
+func (r *StartupCPUBoostProfileReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result,
+	error) {
+	log := r.Log.WithValues("profile", req.Name, "namespace", req.Namespace)
+	boosts, err := referencingBoosts(ctx, r.Client, req.Namespace, req.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var errs []error
+	for i := range boosts {
+		boostObj := &boosts[i]
+		log.V(5).Info("updating boost referencing profile", "boost", boostObj.Name,
+			"boostNamespace", boostObj.Namespace)
+		if err := r.Manager.UpdateRegularCPUBoost(ctx, boostObj); err != nil {
+			log.Error(err, "boost update error", "boost", boostObj.Name, "boostNamespace", boostObj.Namespace)
+			errs = append(errs, err)
+		}
+	}
+	return ctrl.Result{}, errors.Join(errs...)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StartupCPUBoostProfileReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	needLeaderElection := false
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&autoscaling.StartupCPUBoostProfile{}).
+		Watches(&autoscaling.ClusterStartupCPUBoostProfile{}, &handler.EnqueueRequestForObject{}).
+		WithOptions(ctrlcontroller.Options{NeedLeaderElection: &needLeaderElection}).
+		Complete(r)
+}
+
+// profileReference returns the reference to the profile with a given namespace and
+// name. The profile without namespace is the ClusterStartupCPUBoostProfile.
+func profileReference(namespace, name string) autoscaling.ProfileReference {
+	if namespace == "" {
+		return autoscaling.ProfileReference{Kind: autoscaling.ProfileKindCluster, Name: name}
+	}
+	return autoscaling.ProfileReference{Kind: autoscaling.ProfileKindNamespaced, Name: name}
+}
+
+// referencingBoosts returns the StartupCPUBoosts referencing the profile with a given
+// namespace and name. The namespaced profile is referenced only by the boosts in
+// its namespace.
+func referencingBoosts(ctx context.Context, c client.Reader, namespace,
+	name string) ([]autoscaling.StartupCPUBoost, error) {
+	var boostList autoscaling.StartupCPUBoostList
+	if err := c.List(ctx, &boostList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	ref := profileReference(namespace, name)
+	var result []autoscaling.StartupCPUBoost
+	for _, boostObj := range boostList.Items {
+		if boost.ReferencesProfile(boostObj.Spec, ref) {
+			result = append(result, boostObj)
+		}
+	}
+	return result, nil
+}
+
+// profileBoostRequests maps the profile to the reconcile requests of the StartupCPUBoosts
+// referencing it, so their ProfileResolved condition is refreshed
+func (r *StartupCPUBoostReconciler) profileBoostRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	boosts, err := referencingBoosts(ctx, r.Client, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		r.Log.Error(err, "failed to list boosts referencing profile", "profile", obj.GetName(),
+			"namespace", obj.GetNamespace())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(boosts))
+	for _, boostObj := range boosts {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&boostObj)})
+	}
+	return requests
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	autoscaling "github.com/google/kube-startup-cpu-boost/api/v1beta1"
+	"github.com/google/kube-startup-cpu-boost/internal/controller"
+	"github.com/google/kube-startup-cpu-boost/internal/mock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ProfileController", func() {
+	var (
+		mockCtrl    *gomock.Controller
+		mockClient  *mock.MockClient
+		mockManager *mock.MockManager
+		profileCtrl controller.StartupCPUBoostProfileReconciler
+		boosts      []autoscaling.StartupCPUBoost
+		req         ctrl.Request
+		err         error
+	)
+	BeforeEach(func() {
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClient = mock.NewMockClient(mockCtrl)
+		mockManager = mock.NewMockManager(mockCtrl)
+		profileCtrl = controller.StartupCPUBoostProfileReconciler{
+			Log:     logr.Discard(),
+			Client:  mockClient,
+			Manager: mockManager,
+		}
+		boosts = []autoscaling.StartupCPUBoost{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "boost-001", Namespace: "demo"},
+				Spec: autoscaling.StartupCPUBoostSpec{
+					ProfileRef: &autoscaling.ProfileReference{Name: "jvm"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "boost-002", Namespace: "demo"},
+				Spec: autoscaling.StartupCPUBoostSpec{
+					ProfileRef: &autoscaling.ProfileReference{Kind: autoscaling.ProfileKindCluster, Name: "jvm"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "boost-003", Namespace: "other"},
+				Spec: autoscaling.StartupCPUBoostSpec{
+					ProfileRef: &autoscaling.ProfileReference{Kind: autoscaling.ProfileKindCluster, Name: "jvm"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "boost-004", Namespace: "demo"},
+			},
+		}
+		mockClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&autoscaling.StartupCPUBoostList{}),
+			gomock.Any()).
+			DoAndReturn(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				listOpts := &client.ListOptions{}
+				listOpts.ApplyOptions(opts)
+				for _, boostObj := range boosts {
+					if listOpts.Namespace == "" || listOpts.Namespace == boostObj.Namespace {
+						list.(*autoscaling.StartupCPUBoostList).Items =
+							append(list.(*autoscaling.StartupCPUBoostList).Items, boostObj)
+					}
+				}
+				return nil
+			}).Times(1)
+	})
+	JustBeforeEach(func() {
+		_, err = profileCtrl.Reconcile(context.TODO(), req)
+	})
+	When("namespaced profile changes", func() {
+		BeforeEach(func() {
+			req = ctrl.Request{NamespacedName: types.NamespacedName{Name: "jvm", Namespace: "demo"}}
+			mockManager.EXPECT().UpdateRegularCPUBoost(gomock.Any(), gomock.Cond(func(b any) bool {
+				return b.(*autoscaling.StartupCPUBoost).Name == "boost-001"
+			})).Return(nil).Times(1)
+		})
+		It("updates the boosts referencing the profile", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+	When("cluster profile changes", func() {
+		BeforeEach(func() {
+			req = ctrl.Request{NamespacedName: types.NamespacedName{Name: "jvm"}}
+			mockManager.EXPECT().UpdateRegularCPUBoost(gomock.Any(), gomock.Cond(func(b any) bool {
+				name := b.(*autoscaling.StartupCPUBoost).Name
+				return name == "boost-002" || name == "boost-003"
+			})).Return(nil).Times(2)
+		})
+		It("updates the boosts referencing the profile in all namespaces", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+	When("boost update fails", func() {
+		var updateErr error
+		BeforeEach(func() {
+			updateErr = errors.New("update error")
+			req = ctrl.Request{NamespacedName: types.NamespacedName{Name: "jvm"}}
+			mockManager.EXPECT().UpdateRegularCPUBoost(gomock.Any(), gomock.Cond(func(b any) bool {
+				return b.(*autoscaling.StartupCPUBoost).Name == "boost-002"
+			})).Return(updateErr).Times(1)
+			mockManager.EXPECT().UpdateRegularCPUBoost(gomock.Any(), gomock.Cond(func(b any) bool {
+				return b.(*autoscaling.StartupCPUBoost).Name == "boost-003"
+			})).Return(nil).Times(1)
+		})
+		It("updates the remaining boosts and returns the error", func() {
+			Expect(err).To(MatchError(updateErr))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MaxWebhookLabelSets is the maximum number of distinct namespace and boost
+	// label pairs of the webhook metrics
+	MaxWebhookLabelSets = 500
+	// OverflowLabelValue is the label value of the metrics recorded over the
+	// cardinality limit
+	OverflowLabelValue = "_overflow"
+)
+
+type labelSet struct {
+	namespace string
+	boost     string
+}
+
+// labelGuard bounds the number of distinct namespace and boost label pairs.
+// Pairs seen after the limit is reached are replaced with the overflow value.
+type labelGuard struct {
+	mu    sync.Mutex
+	limit int
+	seen  map[labelSet]struct{}
+}
+
+func newLabelGuard(limit int) *labelGuard {
+	return &labelGuard{
+		limit: limit,
+		seen:  make(map[labelSet]struct{}),
+	}
+}
+
+// labels returns the namespace and boost labels, replaced with the overflow
+// value when the limit of distinct pairs is reached
+func (g *labelGuard) labels(namespace string, boost string) prometheus.Labels {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	set := labelSet{namespace: namespace, boost: boost}
+	if _, ok := g.seen[set]; !ok {
+		if len(g.seen) >= g.limit {
+			return prometheus.Labels{"namespace": OverflowLabelValue, "boost": OverflowLabelValue}
+		}
+		g.seen[set] = struct{}{}
+	}
+	return prometheus.Labels{"namespace": namespace, "boost": boost}
+}
+
+// forget releases the namespace and boost label pair
+func (g *labelGuard) forget(namespace string, boost string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.seen, labelSet{namespace: namespace, boost: boost})
+}
+
+// reset releases all of the label pairs
+func (g *labelGuard) reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	clear(g.seen)
+}
@@ -23,6 +23,20 @@ import (
 
 const KubeStartupCPUBoostSubsystem = "boost"
 
+const (
+	// WebhookOutcomeMatched is an outcome of a POD admission matched by a boost
+	WebhookOutcomeMatched = "matched"
+	// WebhookOutcomeNoMatch is an outcome of a POD admission not matched by any boost
+	WebhookOutcomeNoMatch = "no_match"
+	// WebhookOutcomeApplied is an outcome of a POD admission mutated by a boost
+	WebhookOutcomeApplied = "applied"
+	// WebhookOutcomeErrored is an outcome of a POD admission that failed
+	WebhookOutcomeErrored = "errored"
+	// WebhookOutcomeDryRun is an outcome of a POD admission annotated by a boost
+	// in the dry-run mode
+	WebhookOutcomeDryRun = "dry_run"
+)
+
 var (
 	// boostConfigurations is a number of the container
 	// boost configurations registered in a boost manager.
@@ -33,6 +47,43 @@ var (
 	// boostContainersActive is a number of a containers which
 	// CPU resources and not yet reverted to their original values.
 	boostContainersActive *prometheus.GaugeVec
+	// boostResizeOutcomes is a number of a containers which
+	// in-place resize was deferred or found infeasible by the kubelet.
+	boostResizeOutcomes *prometheus.CounterVec
+	// boostDuration is a duration of a POD boost from the boost
+	// time to the revert of the resources.
+	boostDuration *prometheus.HistogramVec
+	// podStartupLatency is a duration between a boosted POD being
+	// scheduled and becoming ready.
+	podStartupLatency *prometheus.HistogramVec
+	// revertLag is a duration between the boost duration policy
+	// deadline and the successful revert of POD resources.
+	revertLag *prometheus.HistogramVec
+	// revertFailures is a number of a failed POD resource reverts.
+	revertFailures *prometheus.CounterVec
+	// skippedContainers is a number of a containers that matched
+	// the resource policy but were not boosted.
+	skippedContainers *prometheus.CounterVec
+	// boostExtraCPU is a number of CPU cores granted on top of the
+	// original CPU requests of not yet reverted containers.
+	boostExtraCPU *prometheus.GaugeVec
+	// boostExtraCPUCoreSeconds is a number of extra CPU core-seconds
+	// granted to the containers from the boost to the revert.
+	boostExtraCPUCoreSeconds *prometheus.CounterVec
+	// dryRunContainers is a number of a containers which CPU resources
+	// would be increased by a boost in the dry-run mode.
+	dryRunContainers *prometheus.CounterVec
+	// webhookDuration is a duration of a POD admission handled by
+	// the mutating webhook.
+	webhookDuration *prometheus.HistogramVec
+	// webhookRequests is a number of a POD admissions handled by
+	// the mutating webhook, by the outcome.
+	webhookRequests *prometheus.CounterVec
+	// webhookPatchSize is a size of a JSON patch returned by the
+	// mutating webhook.
+	webhookPatchSize *prometheus.HistogramVec
+	// webhookLabels bounds the cardinality of the webhook metrics.
+	webhookLabels = newLabelGuard(MaxWebhookLabelSets)
 )
 
 // init initializes all of the Kube Startup CPU Boost metrics.
@@ -58,6 +109,95 @@ func init() {
 			Help:      "Number of a containers which CPU resources and not yet reverted to their original values",
 		}, []string{"namespace", "boost"},
 	)
+	boostResizeOutcomes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "resize_outcomes_total",
+			Help:      "Number of a containers which in-place resize was deferred or found infeasible",
+		}, []string{"namespace", "boost", "outcome"},
+	)
+	boostDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "duration_seconds",
+			Help:      "Duration of a POD boost from the boost time to the revert of the resources",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+		}, []string{"namespace", "boost"},
+	)
+	podStartupLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "pod_startup_latency_seconds",
+			Help:      "Duration between a boosted POD being scheduled and becoming ready",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+		}, []string{"namespace", "boost"},
+	)
+	revertLag = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "revert_lag_seconds",
+			Help:      "Duration between the boost duration policy deadline and the successful revert of POD resources",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+		}, []string{"namespace", "boost"},
+	)
+	revertFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "revert_failures_total",
+			Help:      "Number of a failed POD resource reverts",
+		}, []string{"namespace", "boost"},
+	)
+	skippedContainers = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "skipped_containers_total",
+			Help:      "Number of a containers that matched the resource policy but were not boosted",
+		}, []string{"namespace", "boost", "reason"},
+	)
+	boostExtraCPU = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "extra_cpu_cores",
+			Help:      "Number of CPU cores granted on top of the original CPU requests of not yet reverted containers",
+		}, []string{"namespace", "boost"},
+	)
+	boostExtraCPUCoreSeconds = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "extra_cpu_core_seconds_total",
+			Help:      "Number of extra CPU core-seconds granted to the containers from the boost to the revert",
+		}, []string{"namespace", "boost"},
+	)
+	dryRunContainers = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "dry_run_containers_total",
+			Help:      "Number of a containers which CPU resources would be increased by a boost in the dry-run mode",
+		}, []string{"namespace", "boost"},
+	)
+	webhookDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "webhook_duration_seconds",
+			Help:      "Duration of a POD admission handled by the mutating webhook",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 12),
+		}, []string{"namespace", "boost"},
+	)
+	webhookRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "webhook_requests_total",
+			Help:      "Number of a POD admissions handled by the mutating webhook",
+		}, []string{"namespace", "boost", "outcome"},
+	)
+	webhookPatchSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: KubeStartupCPUBoostSubsystem,
+			Name:      "webhook_patch_size_bytes",
+			Help:      "Size of a JSON patch returned by the mutating webhook",
+			Buckets:   prometheus.ExponentialBuckets(128, 2, 10),
+		}, []string{"namespace", "boost"},
+	)
 }
 
 // Register registers all of the Kube Startup CPU Boost metrics
@@ -67,6 +207,18 @@ func Register() {
 		boostConfigurations,
 		boostContainersTotal,
 		boostContainersActive,
+		boostResizeOutcomes,
+		boostDuration,
+		podStartupLatency,
+		revertLag,
+		revertFailures,
+		skippedContainers,
+		boostExtraCPU,
+		boostExtraCPUCoreSeconds,
+		dryRunContainers,
+		webhookDuration,
+		webhookRequests,
+		webhookPatchSize,
 	)
 }
 
@@ -102,11 +254,113 @@ func AddBoostContainersTotal(namespace string, boost string, value float64) {
 		Add(value)
 }
 
+// AddBoostResizeOutcome adds the given value to the resize outcomes metric
+// for a given namespace, boost name and outcome
+func AddBoostResizeOutcome(namespace string, boost string, outcome string, value float64) {
+	boostResizeOutcomes.With(
+		prometheus.Labels{"namespace": namespace, "boost": boost, "outcome": outcome}).
+		Add(value)
+}
+
+// ObserveBoostDuration records the boost duration in seconds for a given
+// namespace and boost name
+func ObserveBoostDuration(namespace string, boost string, value float64) {
+	boostDuration.With(
+		prometheus.Labels{"namespace": namespace, "boost": boost}).
+		Observe(value)
+}
+
+// ObservePodStartupLatency records the POD startup latency in seconds for
+// a given namespace and boost name
+func ObservePodStartupLatency(namespace string, boost string, value float64) {
+	podStartupLatency.With(
+		prometheus.Labels{"namespace": namespace, "boost": boost}).
+		Observe(value)
+}
+
+// ObserveRevertLag records the revert lag in seconds for a given namespace
+// and boost name
+func ObserveRevertLag(namespace string, boost string, value float64) {
+	revertLag.With(
+		prometheus.Labels{"namespace": namespace, "boost": boost}).
+		Observe(value)
+}
+
+// IncRevertFailures increments the revert failures metric for a given
+// namespace and boost name
+func IncRevertFailures(namespace string, boost string) {
+	revertFailures.With(
+		prometheus.Labels{"namespace": namespace, "boost": boost}).
+		Inc()
+}
+
+// AddSkippedContainers adds the given value to the skipped containers metric
+// for a given namespace, boost name and reason
+func AddSkippedContainers(namespace string, boost string, reason string, value float64) {
+	skippedContainers.With(
+		prometheus.Labels{"namespace": namespace, "boost": boost, "reason": reason}).
+		Add(value)
+}
+
+// SetBoostExtraCPU updates the extra CPU cores metric for a given namespace
+// and boost name with a given value
+func SetBoostExtraCPU(namespace string, boost string, value float64) {
+	boostExtraCPU.With(
+		prometheus.Labels{"namespace": namespace, "boost": boost}).
+		Set(value)
+}
+
+// AddBoostExtraCPUCoreSeconds adds the given value to the extra CPU
+// core-seconds metric for a given namespace and boost name
+func AddBoostExtraCPUCoreSeconds(namespace string, boost string, value float64) {
+	boostExtraCPUCoreSeconds.With(
+		prometheus.Labels{"namespace": namespace, "boost": boost}).
+		Add(value)
+}
+
+// AddDryRunContainers adds the given value to the dry-run containers
+// metric for a given namespace and boost name
+func AddDryRunContainers(namespace string, boost string, value float64) {
+	dryRunContainers.With(
+		prometheus.Labels{"namespace": namespace, "boost": boost}).
+		Add(value)
+}
+
+// ObserveWebhookDuration records the POD admission duration in seconds for
+// a given namespace and boost name
+func ObserveWebhookDuration(namespace string, boost string, value float64) {
+	webhookDuration.With(webhookLabels.labels(namespace, boost)).
+		Observe(value)
+}
+
+// IncWebhookRequests increments the webhook requests metric for a given
+// namespace, boost name and outcome
+func IncWebhookRequests(namespace string, boost string, outcome string) {
+	labels := webhookLabels.labels(namespace, boost)
+	labels["outcome"] = outcome
+	webhookRequests.With(labels).Inc()
+}
+
+// ObserveWebhookPatchSize records the JSON patch size in bytes for a given
+// namespace and boost name
+func ObserveWebhookPatchSize(namespace string, boost string, value float64) {
+	webhookPatchSize.With(webhookLabels.labels(namespace, boost)).
+		Observe(value)
+}
+
 // ClearSystemMetrics clears all of the system metrics.
 func ClearSystemMetrics() {
 	boostConfigurations.Reset()
 }
 
+// ClearWebhookMetrics clears all of the webhook metrics.
+func ClearWebhookMetrics() {
+	webhookDuration.Reset()
+	webhookRequests.Reset()
+	webhookPatchSize.Reset()
+	webhookLabels.reset()
+}
+
 // ClearBoostMetrics clears all of relevant metrics for given
 // namespace and boost
 func ClearBoostMetrics(namespace string, boost string) {
@@ -116,6 +370,43 @@ func ClearBoostMetrics(namespace string, boost string) {
 	boostContainersActive.Delete(
 		prometheus.Labels{"namespace": namespace, "boost": boost},
 	)
+	boostResizeOutcomes.DeletePartialMatch(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	boostDuration.Delete(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	podStartupLatency.Delete(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	revertLag.Delete(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	revertFailures.Delete(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	skippedContainers.DeletePartialMatch(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	boostExtraCPU.Delete(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	boostExtraCPUCoreSeconds.Delete(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	dryRunContainers.Delete(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	webhookDuration.Delete(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	webhookRequests.DeletePartialMatch(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	webhookPatchSize.Delete(
+		prometheus.Labels{"namespace": namespace, "boost": boost},
+	)
+	webhookLabels.forget(namespace, boost)
 }
 
 // BoostConfigurations returns value for a totalBoostConfigurations
@@ -144,6 +435,117 @@ func BoostContainersActive(namespace string, boost string) float64 {
 	})
 }
 
+// BoostResizeOutcomes returns value for a resize outcomes metric
+// for a given namespace, boost name and outcome.
+func BoostResizeOutcomes(namespace string, boost string, outcome string) float64 {
+	return counterVecValue(boostResizeOutcomes, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+		"outcome":   outcome,
+	})
+}
+
+// BoostDurationCount returns the number of observations of a boost
+// duration metric for a given namespace and boost name.
+func BoostDurationCount(namespace string, boost string) uint64 {
+	return histogramVecCount(boostDuration, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+	})
+}
+
+// PodStartupLatencyCount returns the number of observations of a POD
+// startup latency metric for a given namespace and boost name.
+func PodStartupLatencyCount(namespace string, boost string) uint64 {
+	return histogramVecCount(podStartupLatency, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+	})
+}
+
+// RevertLagCount returns the number of observations of a revert lag
+// metric for a given namespace and boost name.
+func RevertLagCount(namespace string, boost string) uint64 {
+	return histogramVecCount(revertLag, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+	})
+}
+
+// RevertFailures returns value for a revert failures metric
+// for a given namespace and boost name.
+func RevertFailures(namespace string, boost string) float64 {
+	return counterVecValue(revertFailures, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+	})
+}
+
+// SkippedContainers returns value for a skipped containers metric
+// for a given namespace, boost name and reason.
+func SkippedContainers(namespace string, boost string, reason string) float64 {
+	return counterVecValue(skippedContainers, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+		"reason":    reason,
+	})
+}
+
+// BoostExtraCPU returns value for an extra CPU cores metric
+// for a given namespace and boost name.
+func BoostExtraCPU(namespace string, boost string) float64 {
+	return gaugeVecValue(boostExtraCPU, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+	})
+}
+
+// BoostExtraCPUCoreSeconds returns value for an extra CPU core-seconds
+// metric for a given namespace and boost name.
+func BoostExtraCPUCoreSeconds(namespace string, boost string) float64 {
+	return counterVecValue(boostExtraCPUCoreSeconds, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+	})
+}
+
+// DryRunContainers returns value for a dry-run containers metric
+// for a given namespace and boost name.
+func DryRunContainers(namespace string, boost string) float64 {
+	return counterVecValue(dryRunContainers, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+	})
+}
+
+// WebhookDurationCount returns the number of observations of a webhook
+// duration metric for a given namespace and boost name.
+func WebhookDurationCount(namespace string, boost string) uint64 {
+	return histogramVecCount(webhookDuration, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+	})
+}
+
+// WebhookRequests returns value for a webhook requests metric
+// for a given namespace, boost name and outcome.
+func WebhookRequests(namespace string, boost string, outcome string) float64 {
+	return counterVecValue(webhookRequests, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+		"outcome":   outcome,
+	})
+}
+
+// WebhookPatchSizeCount returns the number of observations of a webhook
+// patch size metric for a given namespace and boost name.
+func WebhookPatchSizeCount(namespace string, boost string) uint64 {
+	return histogramVecCount(webhookPatchSize, prometheus.Labels{
+		"namespace": namespace,
+		"boost":     boost,
+	})
+}
+
 // CounterVecValue collects and returns value for a counterVec
 // metric for a given labels. Created for purpose of tests.
 func counterVecValue(vec *prometheus.CounterVec, labels prometheus.Labels) (value float64) {
@@ -170,6 +572,19 @@ func gaugeVecValue(vec *prometheus.GaugeVec, labels prometheus.Labels) (value fl
 	return
 }
 
+// histogramVecCount collects and returns the number of observations for
+// a histogramVec metric for a given labels. Created for purpose of tests.
+func histogramVecCount(vec *prometheus.HistogramVec, labels prometheus.Labels) (count uint64) {
+	obs, err := vec.GetMetricWith(labels)
+	if err != nil {
+		return
+	}
+	collect(obs.(prometheus.Histogram), func(m *dto.Metric) {
+		count += m.GetHistogram().GetSampleCount()
+	})
+	return
+}
+
 // collect collects the given prometheus collector and writes
 // corresponding metric to the DTO object for further processing.
 func collect(col prometheus.Collector, do func(*dto.Metric)) {
@@ -15,6 +15,8 @@
 package metrics_test
 
 import (
+	"fmt"
+
 	"github.com/google/kube-startup-cpu-boost/internal/metrics"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -85,4 +87,161 @@ var _ = Describe("Metrics", func() {
 			Expect(metrics.BoostContainersTotal(namespace, boost)).To(Equal(float64(8)))
 		})
 	})
+	Describe("adds boost resize outcome metric", func() {
+		var (
+			namespace = "default"
+			boost     = "boost-01"
+		)
+		BeforeEach(func() {
+			metrics.ClearBoostMetrics(namespace, boost)
+		})
+		JustBeforeEach(func() {
+			metrics.AddBoostResizeOutcome(namespace, boost, "Infeasible", 2)
+			metrics.AddBoostResizeOutcome(namespace, boost, "Deferred", 1)
+		})
+		It("updates the resize outcomes metric", func() {
+			Expect(metrics.BoostResizeOutcomes(namespace, boost, "Infeasible")).To(Equal(float64(2)))
+			Expect(metrics.BoostResizeOutcomes(namespace, boost, "Deferred")).To(Equal(float64(1)))
+		})
+	})
+	Describe("observes boost histogram metrics", func() {
+		var (
+			namespace = "default"
+			boost     = "boost-01"
+		)
+		BeforeEach(func() {
+			metrics.ClearBoostMetrics(namespace, boost)
+		})
+		JustBeforeEach(func() {
+			metrics.ObserveBoostDuration(namespace, boost, 30)
+			metrics.ObserveBoostDuration(namespace, boost, 60)
+			metrics.ObservePodStartupLatency(namespace, boost, 25)
+			metrics.ObserveRevertLag(namespace, boost, 0.5)
+		})
+		It("updates the boost duration metric", func() {
+			Expect(metrics.BoostDurationCount(namespace, boost)).To(Equal(uint64(2)))
+		})
+		It("updates the pod startup latency metric", func() {
+			Expect(metrics.PodStartupLatencyCount(namespace, boost)).To(Equal(uint64(1)))
+		})
+		It("updates the revert lag metric", func() {
+			Expect(metrics.RevertLagCount(namespace, boost)).To(Equal(uint64(1)))
+		})
+	})
+	Describe("adds revert failures and skipped containers metrics", func() {
+		var (
+			namespace = "default"
+			boost     = "boost-01"
+		)
+		BeforeEach(func() {
+			metrics.ClearBoostMetrics(namespace, boost)
+		})
+		JustBeforeEach(func() {
+			metrics.IncRevertFailures(namespace, boost)
+			metrics.IncRevertFailures(namespace, boost)
+			metrics.AddSkippedContainers(namespace, boost, "SkippedQoSChange", 2)
+		})
+		It("updates the revert failures metric", func() {
+			Expect(metrics.RevertFailures(namespace, boost)).To(Equal(float64(2)))
+		})
+		It("updates the skipped containers metric", func() {
+			Expect(metrics.SkippedContainers(namespace, boost, "SkippedQoSChange")).To(Equal(float64(2)))
+		})
+	})
+	Describe("adds extra CPU metrics", func() {
+		var (
+			namespace = "default"
+			boost     = "boost-01"
+		)
+		BeforeEach(func() {
+			metrics.ClearBoostMetrics(namespace, boost)
+		})
+		JustBeforeEach(func() {
+			metrics.SetBoostExtraCPU(namespace, boost, 1.5)
+			metrics.AddBoostExtraCPUCoreSeconds(namespace, boost, 30)
+			metrics.AddBoostExtraCPUCoreSeconds(namespace, boost, 15)
+		})
+		It("updates the extra CPU metric", func() {
+			Expect(metrics.BoostExtraCPU(namespace, boost)).To(Equal(1.5))
+		})
+		It("updates the extra CPU core-seconds metric", func() {
+			Expect(metrics.BoostExtraCPUCoreSeconds(namespace, boost)).To(Equal(float64(45)))
+		})
+	})
+	Describe("adds dry-run metrics", func() {
+		var (
+			namespace = "default"
+			boost     = "boost-01"
+		)
+		BeforeEach(func() {
+			metrics.ClearBoostMetrics(namespace, boost)
+		})
+		JustBeforeEach(func() {
+			metrics.AddDryRunContainers(namespace, boost, 2)
+			metrics.AddDryRunContainers(namespace, boost, 1)
+		})
+		It("updates the dry-run containers metric", func() {
+			Expect(metrics.DryRunContainers(namespace, boost)).To(Equal(float64(3)))
+		})
+		When("boost metrics are cleared", func() {
+			JustBeforeEach(func() {
+				metrics.ClearBoostMetrics(namespace, boost)
+			})
+			It("clears the dry-run containers metric", func() {
+				Expect(metrics.DryRunContainers(namespace, boost)).To(BeZero())
+			})
+		})
+	})
+	Describe("adds webhook metrics", func() {
+		var (
+			namespace = "default"
+			boost     = "boost-01"
+		)
+		BeforeEach(func() {
+			metrics.ClearWebhookMetrics()
+		})
+		JustBeforeEach(func() {
+			metrics.ObserveWebhookDuration(namespace, boost, 0.01)
+			metrics.IncWebhookRequests(namespace, boost, metrics.WebhookOutcomeMatched)
+			metrics.IncWebhookRequests(namespace, boost, metrics.WebhookOutcomeApplied)
+			metrics.ObserveWebhookPatchSize(namespace, boost, 512)
+		})
+		It("updates the webhook duration metric", func() {
+			Expect(metrics.WebhookDurationCount(namespace, boost)).To(Equal(uint64(1)))
+		})
+		It("updates the webhook requests metric", func() {
+			Expect(metrics.WebhookRequests(namespace, boost, metrics.WebhookOutcomeMatched)).To(Equal(float64(1)))
+			Expect(metrics.WebhookRequests(namespace, boost, metrics.WebhookOutcomeApplied)).To(Equal(float64(1)))
+		})
+		It("updates the webhook patch size metric", func() {
+			Expect(metrics.WebhookPatchSizeCount(namespace, boost)).To(Equal(uint64(1)))
+		})
+		When("boost metrics are cleared", func() {
+			JustBeforeEach(func() {
+				metrics.ClearBoostMetrics(namespace, boost)
+			})
+			It("clears the webhook metrics", func() {
+				Expect(metrics.WebhookDurationCount(namespace, boost)).To(BeZero())
+				Expect(metrics.WebhookRequests(namespace, boost, metrics.WebhookOutcomeMatched)).To(BeZero())
+			})
+		})
+		When("the cardinality limit is reached", func() {
+			JustBeforeEach(func() {
+				// the default namespace and boost labels are already recorded
+				for i := 1; i < metrics.MaxWebhookLabelSets; i++ {
+					metrics.IncWebhookRequests(fmt.Sprintf("ns-%d", i), boost, metrics.WebhookOutcomeNoMatch)
+				}
+			})
+			It("records the known label values", func() {
+				metrics.IncWebhookRequests(namespace, boost, metrics.WebhookOutcomeMatched)
+				Expect(metrics.WebhookRequests(namespace, boost, metrics.WebhookOutcomeMatched)).To(Equal(float64(2)))
+			})
+			It("records new label values with the overflow value", func() {
+				metrics.IncWebhookRequests("ns-new", boost, metrics.WebhookOutcomeNoMatch)
+				Expect(metrics.WebhookRequests("ns-new", boost, metrics.WebhookOutcomeNoMatch)).To(BeZero())
+				Expect(metrics.WebhookRequests(metrics.OverflowLabelValue, metrics.OverflowLabelValue,
+					metrics.WebhookOutcomeNoMatch)).To(Equal(float64(1)))
+			})
+		})
+	})
 })
@@ -26,7 +26,7 @@ import (
 	context "context"
 	reflect "reflect"
 
-	v1alpha1 "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	v1beta1 "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	boost "github.com/google/kube-startup-cpu-boost/internal/boost"
 	pod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
 	gomock "go.uber.org/mock/gomock"
@@ -84,6 +84,20 @@ func (mr *MockManagerMockRecorder) DeleteRegularCPUBoost(ctx, name, namespace an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRegularCPUBoost", reflect.TypeOf((*MockManager)(nil).DeleteRegularCPUBoost), ctx, name, namespace)
 }
 
+// ConflictingCPUBoosts mocks base method.
+func (m *MockManager) ConflictingCPUBoosts(ctx context.Context, name, namespace string) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConflictingCPUBoosts", ctx, name, namespace)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ConflictingCPUBoosts indicates an expected call of ConflictingCPUBoosts.
+func (mr *MockManagerMockRecorder) ConflictingCPUBoosts(ctx, name, namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConflictingCPUBoosts", reflect.TypeOf((*MockManager)(nil).ConflictingCPUBoosts), ctx, name, namespace)
+}
+
 // GetCPUBoostForPod mocks base method.
 func (m *MockManager) GetCPUBoostForPod(ctx context.Context, arg1 *v1.Pod) (boost.StartupCPUBoost, bool) {
 	m.ctrl.T.Helper()
@@ -143,6 +157,20 @@ func (mr *MockManagerMockRecorder) IsRunning(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRunning", reflect.TypeOf((*MockManager)(nil).IsRunning), ctx)
 }
 
+// RegularCPUBoostNamespaces mocks base method.
+func (m *MockManager) RegularCPUBoostNamespaces(ctx context.Context) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegularCPUBoostNamespaces", ctx)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// RegularCPUBoostNamespaces indicates an expected call of RegularCPUBoostNamespaces.
+func (mr *MockManagerMockRecorder) RegularCPUBoostNamespaces(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegularCPUBoostNamespaces", reflect.TypeOf((*MockManager)(nil).RegularCPUBoostNamespaces), ctx)
+}
+
 // SetStartupCPUBoostReconciler mocks base method.
 func (m *MockManager) SetStartupCPUBoostReconciler(reconciler reconcile.Reconciler) {
 	m.ctrl.T.Helper()
@@ -170,7 +198,7 @@ func (mr *MockManagerMockRecorder) Start(ctx any) *gomock.Call {
 }
 
 // UpdateRegularCPUBoost mocks base method.
-func (m *MockManager) UpdateRegularCPUBoost(ctx context.Context, spec *v1alpha1.StartupCPUBoost) error {
+func (m *MockManager) UpdateRegularCPUBoost(ctx context.Context, spec *v1beta1.StartupCPUBoost) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateRegularCPUBoost", ctx, spec)
 	ret0, _ := ret[0].(error)
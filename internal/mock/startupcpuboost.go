@@ -26,12 +26,14 @@ import (
 	context "context"
 	reflect "reflect"
 
-	v1alpha1 "github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	v1beta1 "github.com/google/kube-startup-cpu-boost/api/v1beta1"
 	boost "github.com/google/kube-startup-cpu-boost/internal/boost"
 	duration "github.com/google/kube-startup-cpu-boost/internal/boost/duration"
 	pod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
 	gomock "go.uber.org/mock/gomock"
+	v10 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/api/core/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
 )
 
 // MockStartupCPUBoost is a mock of StartupCPUBoost interface.
@@ -59,17 +61,18 @@ func (m *MockStartupCPUBoost) EXPECT() *MockStartupCPUBoostMockRecorder {
 }
 
 // ApplyResourcePolicy mocks base method.
-func (m *MockStartupCPUBoost) ApplyResourcePolicy(ctx context.Context, pod *v1.Pod) error {
+func (m *MockStartupCPUBoost) ApplyResourcePolicy(ctx context.Context, pod *v1.Pod, overrides pod.BoostOverrides) (boost.ResourcePolicyResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ApplyResourcePolicy", ctx, pod)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ApplyResourcePolicy", ctx, pod, overrides)
+	ret0, _ := ret[0].(boost.ResourcePolicyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ApplyResourcePolicy indicates an expected call of ApplyResourcePolicy.
-func (mr *MockStartupCPUBoostMockRecorder) ApplyResourcePolicy(ctx, pod any) *gomock.Call {
+func (mr *MockStartupCPUBoostMockRecorder) ApplyResourcePolicy(ctx, pod, overrides any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyResourcePolicy", reflect.TypeOf((*MockStartupCPUBoost)(nil).ApplyResourcePolicy), ctx, pod)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyResourcePolicy", reflect.TypeOf((*MockStartupCPUBoost)(nil).ApplyResourcePolicy), ctx, pod, overrides)
 }
 
 // BoostResources mocks base method.
@@ -115,17 +118,17 @@ func (mr *MockStartupCPUBoostMockRecorder) HandlePodEvent(ctx, event any) *gomoc
 }
 
 // Matches mocks base method.
-func (m *MockStartupCPUBoost) Matches(pod *v1.Pod) bool {
+func (m *MockStartupCPUBoost) Matches(ctx context.Context, pod *v1.Pod) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Matches", pod)
+	ret := m.ctrl.Call(m, "Matches", ctx, pod)
 	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
 // Matches indicates an expected call of Matches.
-func (mr *MockStartupCPUBoostMockRecorder) Matches(pod any) *gomock.Call {
+func (mr *MockStartupCPUBoostMockRecorder) Matches(ctx, pod any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Matches", reflect.TypeOf((*MockStartupCPUBoost)(nil).Matches), pod)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Matches", reflect.TypeOf((*MockStartupCPUBoost)(nil).Matches), ctx, pod)
 }
 
 // Name mocks base method.
@@ -171,6 +174,62 @@ func (mr *MockStartupCPUBoostMockRecorder) Pod(name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pod", reflect.TypeOf((*MockStartupCPUBoost)(nil).Pod), name)
 }
 
+// Priority mocks base method.
+func (m *MockStartupCPUBoost) Priority() int32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Priority")
+	ret0, _ := ret[0].(int32)
+	return ret0
+}
+
+// Priority indicates an expected call of Priority.
+func (mr *MockStartupCPUBoostMockRecorder) Priority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Priority", reflect.TypeOf((*MockStartupCPUBoost)(nil).Priority))
+}
+
+// Suspended mocks base method.
+func (m *MockStartupCPUBoost) Suspended() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspended")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Suspended indicates an expected call of Suspended.
+func (mr *MockStartupCPUBoostMockRecorder) Suspended() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspended", reflect.TypeOf((*MockStartupCPUBoost)(nil).Suspended))
+}
+
+// ProfileError mocks base method.
+func (m *MockStartupCPUBoost) ProfileError() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProfileError")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProfileError indicates an expected call of ProfileError.
+func (mr *MockStartupCPUBoostMockRecorder) ProfileError() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProfileError", reflect.TypeOf((*MockStartupCPUBoost)(nil).ProfileError))
+}
+
+// ResolveProfile mocks base method.
+func (m *MockStartupCPUBoost) ResolveProfile(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveProfile", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveProfile indicates an expected call of ResolveProfile.
+func (mr *MockStartupCPUBoostMockRecorder) ResolveProfile(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveProfile", reflect.TypeOf((*MockStartupCPUBoost)(nil).ResolveProfile), ctx)
+}
+
 // RevertResources mocks base method.
 func (m *MockStartupCPUBoost) RevertResources(ctx context.Context, pod *v1.Pod) error {
 	m.ctrl.T.Helper()
@@ -185,6 +244,20 @@ func (mr *MockStartupCPUBoostMockRecorder) RevertResources(ctx, pod any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertResources", reflect.TypeOf((*MockStartupCPUBoost)(nil).RevertResources), ctx, pod)
 }
 
+// Selector mocks base method.
+func (m *MockStartupCPUBoost) Selector() labels.Selector {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Selector")
+	ret0, _ := ret[0].(labels.Selector)
+	return ret0
+}
+
+// Selector indicates an expected call of Selector.
+func (mr *MockStartupCPUBoostMockRecorder) Selector() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Selector", reflect.TypeOf((*MockStartupCPUBoost)(nil).Selector))
+}
+
 // Stats mocks base method.
 func (m *MockStartupCPUBoost) Stats() boost.StartupCPUBoostStats {
 	m.ctrl.T.Helper()
@@ -199,8 +272,22 @@ func (mr *MockStartupCPUBoostMockRecorder) Stats() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockStartupCPUBoost)(nil).Stats))
 }
 
+// TargetRef mocks base method.
+func (m *MockStartupCPUBoost) TargetRef() *v10.CrossVersionObjectReference {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TargetRef")
+	ret0, _ := ret[0].(*v10.CrossVersionObjectReference)
+	return ret0
+}
+
+// TargetRef indicates an expected call of TargetRef.
+func (mr *MockStartupCPUBoostMockRecorder) TargetRef() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TargetRef", reflect.TypeOf((*MockStartupCPUBoost)(nil).TargetRef))
+}
+
 // UpdateFromSpec mocks base method.
-func (m *MockStartupCPUBoost) UpdateFromSpec(ctx context.Context, boost *v1alpha1.StartupCPUBoost) error {
+func (m *MockStartupCPUBoost) UpdateFromSpec(ctx context.Context, boost *v1beta1.StartupCPUBoost) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateFromSpec", ctx, boost)
 	ret0, _ := ret[0].(error)
@@ -27,12 +27,15 @@ const (
 	certDir        = "/tmp/k8s-webhook-server/serving-certs"
 	caName         = "kube-startup-cpu-boost-ca"
 	caOrganization = "kube-startup-cpu-boost"
+	crdName        = "startupcpuboosts.autoscaling.x-k8s.io"
 )
 
 //+kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=mutatingwebhookconfigurations,verbs=list;watch
 //+kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=validatingwebhookconfigurations,verbs=list;watch
 //+kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=mutatingwebhookconfigurations,resourceNames=kube-startup-cpu-boost-mutating-webhook-configuration,verbs=get;update
 //+kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=validatingwebhookconfigurations,resourceNames=kube-startup-cpu-boost-validating-webhook-configuration,verbs=get;update
+//+kubebuilder:rbac:groups="apiextensions.k8s.io",resources=customresourcedefinitions,verbs=list;watch
+//+kubebuilder:rbac:groups="apiextensions.k8s.io",resources=customresourcedefinitions,resourceNames=startupcpuboosts.autoscaling.x-k8s.io,verbs=get;update
 
 func ManageCerts(mgr ctrl.Manager, cfg *config.Config, setupFinished chan struct{}) error {
 	dnsName := fmt.Sprintf("%s.%s.svc", cfg.WebhookServiceName, cfg.Namespace)
@@ -52,6 +55,9 @@ func ManageCerts(mgr ctrl.Manager, cfg *config.Config, setupFinished chan struct
 		}, {
 			Type: cert.Validating,
 			Name: cfg.ValidatingWebhookName,
+		}, {
+			Type: cert.CRDConversion,
+			Name: crdName,
 		}},
 		RequireLeaderElection: false,
 	})
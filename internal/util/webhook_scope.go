@@ -0,0 +1,149 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmgr "sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NamespacesFunc returns the sorted names of namespaces the mutating webhook is scoped to
+type NamespacesFunc func(ctx context.Context) []string
+
+// WebhookScoper keeps the namespace selector of the mutating webhook limited to the
+// namespaces returned by NamespacesFunc. The selector requirement on the namespace
+// name label is managed by the scoper, other requirements and other webhooks of the
+// configuration are left unchanged.
+type WebhookScoper interface {
+	ctrlmgr.Runnable
+	ctrlmgr.LeaderElectionRunnable
+}
+
+type webhookScoperImpl struct {
+	client     client.Client
+	reader     client.Reader
+	name       string
+	webhook    string
+	namespaces NamespacesFunc
+	synced     func() bool
+	interval   time.Duration
+	log        logr.Logger
+}
+
+// NewWebhookScoper constructs a new WebhookScoper for a webhook with a given name in
+// a MutatingWebhookConfiguration with a given name. The configuration is read with a given reader, i.e. not cached
+// one, as it is updated by other actors like cert rotator. The selector is not updated
+// until synced returns true, so the webhook is not scoped to partially known namespaces.
+func NewWebhookScoper(client client.Client, reader client.Reader, name, webhook string,
+	namespaces NamespacesFunc, synced func() bool, interval time.Duration) WebhookScoper {
+	return &webhookScoperImpl{
+		client:     client,
+		reader:     reader,
+		name:       name,
+		webhook:    webhook,
+		namespaces: namespaces,
+		synced:     synced,
+		interval:   interval,
+		log:        ctrl.Log.WithName("webhook-scoper"),
+	}
+}
+
+func (s *webhookScoperImpl) NeedLeaderElection() bool {
+	return true
+}
+
+func (s *webhookScoperImpl) Start(ctx context.Context) error {
+	s.log.Info("starting webhook scoper")
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.sync(ctx); err != nil {
+			s.log.Error(err, "failed to scope mutating webhook to boost namespaces")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			s.log.Info("stopping webhook scoper")
+			return nil
+		}
+	}
+}
+
+// sync updates the namespace selector of the mutating webhook when it does not match
+// the namespaces, i.e. the namespaces changed or the configuration was redeployed
+func (s *webhookScoperImpl) sync(ctx context.Context) error {
+	if !s.synced() {
+		s.log.V(5).Info("boosts are not synced yet, skipping")
+		return nil
+	}
+	namespaces := s.namespaces(ctx)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		webhookCfg := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		if err := s.reader.Get(ctx, client.ObjectKey{Name: s.name}, webhookCfg); err != nil {
+			return err
+		}
+		updated := webhookCfg.DeepCopy()
+		for i := range updated.Webhooks {
+			if updated.Webhooks[i].Name != s.webhook {
+				continue
+			}
+			updated.Webhooks[i].NamespaceSelector = ScopeNamespaceSelector(
+				updated.Webhooks[i].NamespaceSelector, namespaces)
+		}
+		if equality.Semantic.DeepEqual(webhookCfg.Webhooks, updated.Webhooks) {
+			return nil
+		}
+		s.log.Info("updating mutating webhook namespace selector", "namespaces", namespaces)
+		return s.client.Update(ctx, updated)
+	})
+}
+
+// ScopeNamespaceSelector returns a copy of a given namespace selector with the requirement
+// on the namespace name label matching only the given namespaces. As the label is set on
+// every namespace, the selector matches no namespace when no namespaces are given.
+func ScopeNamespaceSelector(selector *metav1.LabelSelector, namespaces []string) *metav1.LabelSelector {
+	var result *metav1.LabelSelector
+	if selector != nil {
+		result = selector.DeepCopy()
+	} else {
+		result = &metav1.LabelSelector{}
+	}
+	result.MatchExpressions = slices.DeleteFunc(result.MatchExpressions,
+		func(req metav1.LabelSelectorRequirement) bool {
+			return req.Key == corev1.LabelMetadataName && (req.Operator == metav1.LabelSelectorOpIn ||
+				req.Operator == metav1.LabelSelectorOpDoesNotExist)
+		})
+	requirement := metav1.LabelSelectorRequirement{
+		Key:      corev1.LabelMetadataName,
+		Operator: metav1.LabelSelectorOpDoesNotExist,
+	}
+	if len(namespaces) > 0 {
+		requirement.Operator = metav1.LabelSelectorOpIn
+		requirement.Values = slices.Clone(namespaces)
+	}
+	result.MatchExpressions = append(result.MatchExpressions, requirement)
+	return result
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/kube-startup-cpu-boost/internal/mock"
+	"github.com/google/kube-startup-cpu-boost/internal/util"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Webhook scoper", func() {
+	var (
+		systemNamespaces = metav1.LabelSelectorRequirement{
+			Key:      corev1.LabelMetadataName,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{"kube-system"},
+		}
+	)
+	Describe("scopes namespace selector", func() {
+		var (
+			selector   *metav1.LabelSelector
+			namespaces []string
+			result     *metav1.LabelSelector
+		)
+		BeforeEach(func() {
+			selector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{systemNamespaces},
+			}
+		})
+		JustBeforeEach(func() {
+			result = util.ScopeNamespaceSelector(selector, namespaces)
+		})
+		When("there are namespaces", func() {
+			BeforeEach(func() {
+				namespaces = []string{"ns-001", "ns-002"}
+			})
+			It("adds the namespace name requirement", func() {
+				Expect(result.MatchExpressions).To(Equal([]metav1.LabelSelectorRequirement{
+					systemNamespaces,
+					{
+						Key:      corev1.LabelMetadataName,
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{"ns-001", "ns-002"},
+					},
+				}))
+			})
+			It("does not modify the given selector", func() {
+				Expect(selector.MatchExpressions).To(HaveLen(1))
+			})
+			When("selector is already scoped", func() {
+				BeforeEach(func() {
+					selector = util.ScopeNamespaceSelector(selector, []string{"ns-003"})
+				})
+				It("replaces the namespace name requirement", func() {
+					Expect(result).To(Equal(util.ScopeNamespaceSelector(&metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{systemNamespaces},
+					}, namespaces)))
+				})
+			})
+		})
+		When("there are no namespaces", func() {
+			BeforeEach(func() {
+				namespaces = nil
+			})
+			It("adds the requirement matching no namespace", func() {
+				Expect(result.MatchExpressions).To(ContainElement(metav1.LabelSelectorRequirement{
+					Key:      corev1.LabelMetadataName,
+					Operator: metav1.LabelSelectorOpDoesNotExist,
+				}))
+			})
+		})
+		When("selector is nil", func() {
+			BeforeEach(func() {
+				selector = nil
+				namespaces = []string{"ns-001"}
+			})
+			It("returns the selector with the namespace name requirement", func() {
+				Expect(result.MatchExpressions).To(HaveLen(1))
+			})
+		})
+	})
+	Describe("syncs mutating webhook configuration", func() {
+		var (
+			mockCtrl    *gomock.Controller
+			mockClient  *mock.MockClient
+			synced      bool
+			webhookCfg  *admissionregistrationv1.MutatingWebhookConfiguration
+			updatedCfgs []*admissionregistrationv1.MutatingWebhookConfiguration
+			err         error
+		)
+		BeforeEach(func() {
+			mockCtrl = gomock.NewController(GinkgoT())
+			mockClient = mock.NewMockClient(mockCtrl)
+			synced = true
+			updatedCfgs = nil
+			webhookCfg = &admissionregistrationv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "webhook-cfg"},
+				Webhooks: []admissionregistrationv1.MutatingWebhook{
+					{
+						Name: "cpuboost.autoscaling.x-k8s.io",
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{systemNamespaces},
+						},
+					},
+					{
+						Name: "mstartupcpuboost.autoscaling.x-k8s.io",
+					},
+				},
+			}
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Eq(client.ObjectKey{Name: "webhook-cfg"}),
+				gomock.Any()).AnyTimes().DoAndReturn(func(c context.Context, key client.ObjectKey,
+				obj client.Object, opts ...client.GetOption) error {
+				webhookCfg.DeepCopyInto(obj.(*admissionregistrationv1.MutatingWebhookConfiguration))
+				return nil
+			})
+			mockClient.EXPECT().Update(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(c context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					updatedCfgs = append(updatedCfgs, obj.(*admissionregistrationv1.MutatingWebhookConfiguration))
+					return nil
+				})
+		})
+		JustBeforeEach(func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			scoper := util.NewWebhookScoper(mockClient, mockClient, "webhook-cfg",
+				"cpuboost.autoscaling.x-k8s.io", func(ctx context.Context) []string { return []string{"ns-001"} },
+				func() bool { return synced }, time.Second)
+			err = scoper.Start(ctx)
+		})
+		It("does not error", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("updates the namespace selector", func() {
+			Expect(updatedCfgs).To(HaveLen(1))
+			Expect(updatedCfgs[0].Webhooks[0].NamespaceSelector).To(Equal(
+				util.ScopeNamespaceSelector(webhookCfg.Webhooks[0].NamespaceSelector, []string{"ns-001"})))
+		})
+		It("does not update the namespace selector of other webhooks", func() {
+			Expect(updatedCfgs).To(HaveLen(1))
+			Expect(updatedCfgs[0].Webhooks[1].NamespaceSelector).To(BeNil())
+		})
+		When("namespace selector is up to date", func() {
+			BeforeEach(func() {
+				webhookCfg.Webhooks[0].NamespaceSelector = util.ScopeNamespaceSelector(
+					webhookCfg.Webhooks[0].NamespaceSelector, []string{"ns-001"})
+			})
+			It("does not update the configuration", func() {
+				Expect(updatedCfgs).To(BeEmpty())
+			})
+		})
+		When("boosts are not synced", func() {
+			BeforeEach(func() {
+				synced = false
+			})
+			It("does not update the configuration", func() {
+				Expect(updatedCfgs).To(BeEmpty())
+			})
+		})
+	})
+})
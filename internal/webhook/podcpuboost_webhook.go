@@ -17,38 +17,66 @@ package webhook
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/google/kube-startup-cpu-boost/internal/boost"
+	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	"github.com/google/kube-startup-cpu-boost/internal/metrics"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-// +kubebuilder:webhook:path=/mutate-v1-pod,mutating=true,failurePolicy=ignore,sideEffects=None,timeoutSeconds=2,groups="",resources=pods,verbs=create,versions=v1,name=cpuboost.autoscaling.x-k8s.io,admissionReviewVersions=v1
+const (
+	// PodCPUBoostWebhookName is the name of the POD mutating webhook
+	PodCPUBoostWebhookName = "cpuboost.autoscaling.x-k8s.io"
+	// SkippedContainersAuditAnnotationKey is the key of the audit annotation
+	// summarizing the containers that were not boosted
+	SkippedContainersAuditAnnotationKey = "skipped-containers"
+)
+
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// +kubebuilder:webhook:path=/mutate-v1-pod,mutating=true,failurePolicy=ignore,sideEffects=None,timeoutSeconds=2,groups="",resources=pods,verbs=create,versions=v1,reinvocationPolicy=IfNeeded,name=cpuboost.autoscaling.x-k8s.io,admissionReviewVersions=v1
 
 type podCPUBoostHandler struct {
 	decoder admission.Decoder
 	manager boost.Manager
+	reader  client.Reader
 }
 
-func NewPodCPUBoostWebHook(mgr boost.Manager, scheme *runtime.Scheme) *webhook.Admission {
+// NewPodCPUBoostWebHook constructs the POD mutating webhook. The namespaces are read
+// with a given reader to honour the namespace annotations overriding the boost.
+func NewPodCPUBoostWebHook(mgr boost.Manager, reader client.Reader, scheme *runtime.Scheme) *webhook.Admission {
 	return &webhook.Admission{
 		Handler: &podCPUBoostHandler{
 			manager: mgr,
+			reader:  reader,
 			decoder: admission.NewDecoder(scheme),
 		},
 	}
 }
 
-func (h *podCPUBoostHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+func (h *podCPUBoostHandler) Handle(ctx context.Context, req admission.Request) (resp admission.Response) {
+	start := time.Now()
+	namespace, boostName, dryRun := req.Namespace, "", false
+	defer func() {
+		recordAdmissionMetrics(namespace, boostName, dryRun, resp, time.Since(start))
+	}()
 	pod := &corev1.Pod{}
 	err := h.decoder.Decode(req, pod)
 	if err != nil {
 		return admission.Errored(http.StatusBadRequest, err)
 	}
+	if namespace == "" {
+		namespace = pod.Namespace
+	}
 	log := ctrl.LoggerFrom(ctx).WithName("boost-pod-webhook")
 	log.V(5).Info("handling pod")
 
@@ -57,17 +85,89 @@ func (h *podCPUBoostHandler) Handle(ctx context.Context, req admission.Request)
 		log.V(5).Info("no boost matched")
 		return admission.Allowed("no boost matched")
 	}
-	log = log.WithValues("boost", boostImpl.Name())
+	boostName = boostImpl.Name()
+	log = log.WithValues("boost", boostName)
 
-	err = boostImpl.ApplyResourcePolicy(ctx, pod)
+	overrides := bpod.OverridesFromAnnotations(h.namespaceAnnotations(ctx, namespace), pod.Annotations)
+	if overrides.Disabled {
+		log.V(5).Info("boost disabled by annotation")
+		resp = admission.Allowed("boost disabled by annotation")
+		resp.Warnings = overrides.Warnings
+		return resp
+	}
+	result, err := boostImpl.ApplyResourcePolicy(ctx, pod, overrides)
 	if err != nil {
 		log.Error(err, "failed to apply resource policy")
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
+	dryRun = result.DryRun
 
 	marshaledPod, err := json.Marshal(pod)
 	if err != nil {
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
-	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
+	resp = admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
+	resp.Warnings = append(resp.Warnings, result.Warnings...)
+	return withSkippedContainers(resp, result.SkippedContainers)
+}
+
+// namespaceAnnotations returns the annotations of a namespace with a given name.
+// The namespace annotations are not honoured when the namespace cannot be read,
+// so the admission is not failed.
+func (h *podCPUBoostHandler) namespaceAnnotations(ctx context.Context, name string) map[string]string {
+	ns := &corev1.Namespace{}
+	if err := h.reader.Get(ctx, client.ObjectKey{Name: name}, ns); err != nil {
+		ctrl.LoggerFrom(ctx).WithName("boost-pod-webhook").Error(err,
+			"failed to get namespace, skipping namespace annotations", "namespace", name)
+		return nil
+	}
+	return ns.Annotations
+}
+
+// withSkippedContainers adds the warnings and the audit annotation explaining why
+// the containers were not boosted to the admission response
+func withSkippedContainers(resp admission.Response, skipped []boost.SkippedContainer) admission.Response {
+	if len(skipped) == 0 {
+		return resp
+	}
+	summary := make([]string, 0, len(skipped))
+	for _, container := range skipped {
+		resp.Warnings = append(resp.Warnings,
+			fmt.Sprintf("container %s not boosted: %s", container.Name, container.Message))
+		summary = append(summary, fmt.Sprintf("%s: %s", container.Name, container.Message))
+	}
+	if resp.AuditAnnotations == nil {
+		resp.AuditAnnotations = make(map[string]string)
+	}
+	resp.AuditAnnotations[SkippedContainersAuditAnnotationKey] = strings.Join(summary, "; ")
+	return resp
+}
+
+// recordAdmissionMetrics records the duration and the outcomes of the POD admission.
+// The boost name is empty when no boost matched the POD. The POD patched by the boost
+// in the dry-run mode is recorded with the dry-run outcome.
+func recordAdmissionMetrics(namespace, boostName string, dryRun bool, resp admission.Response,
+	duration time.Duration) {
+	metrics.ObserveWebhookDuration(namespace, boostName, duration.Seconds())
+	if boostName == "" && resp.Allowed {
+		metrics.IncWebhookRequests(namespace, boostName, metrics.WebhookOutcomeNoMatch)
+		return
+	}
+	if boostName != "" {
+		metrics.IncWebhookRequests(namespace, boostName, metrics.WebhookOutcomeMatched)
+	}
+	if !resp.Allowed {
+		metrics.IncWebhookRequests(namespace, boostName, metrics.WebhookOutcomeErrored)
+		return
+	}
+	if len(resp.Patches) > 0 {
+		outcome := metrics.WebhookOutcomeApplied
+		if dryRun {
+			outcome = metrics.WebhookOutcomeDryRun
+		}
+		metrics.IncWebhookRequests(namespace, boostName, outcome)
+		if patch, err := json.Marshal(resp.Patches); err == nil {
+			metrics.ObserveWebhookPatchSize(namespace, boostName, float64(len(patch)))
+		}
+	}
 }
@@ -18,8 +18,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	cpuboost "github.com/google/kube-startup-cpu-boost/internal/boost"
 	bpod "github.com/google/kube-startup-cpu-boost/internal/boost/pod"
+	"github.com/google/kube-startup-cpu-boost/internal/metrics"
 	"github.com/google/kube-startup-cpu-boost/internal/mock"
 	bwebhook "github.com/google/kube-startup-cpu-boost/internal/webhook"
 	. "github.com/onsi/ginkgo/v2"
@@ -31,6 +34,7 @@ import (
 	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -38,15 +42,26 @@ import (
 var _ = Describe("Pod CPU Boost Webhook", func() {
 	Describe("Handles admission requests", func() {
 		var (
-			mockCtrl    *gomock.Controller
-			manager     *mock.MockManager
-			managerCall *gomock.Call
-			pod         *corev1.Pod
-			response    webhook.AdmissionResponse
+			mockCtrl             *gomock.Controller
+			manager              *mock.MockManager
+			managerCall          *gomock.Call
+			mockClient           *mock.MockClient
+			namespaceAnnotations map[string]string
+			pod                  *corev1.Pod
+			response             webhook.AdmissionResponse
 		)
 		BeforeEach(func() {
+			metrics.ClearWebhookMetrics()
 			mockCtrl = gomock.NewController(GinkgoT())
 			manager = mock.NewMockManager(mockCtrl)
+			mockClient = mock.NewMockClient(mockCtrl)
+			namespaceAnnotations = nil
+			mockClient.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.AssignableToTypeOf(&corev1.Namespace{})).
+				DoAndReturn(func(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					obj.SetName(key.Name)
+					obj.SetAnnotations(namespaceAnnotations)
+					return nil
+				}).AnyTimes()
 			managerCall = manager.EXPECT().GetCPUBoostForPod(
 				gomock.Any(),
 				gomock.Cond(func(x any) bool {
@@ -68,7 +83,7 @@ var _ = Describe("Pod CPU Boost Webhook", func() {
 					},
 				},
 			}
-			hook := bwebhook.NewPodCPUBoostWebHook(manager, scheme.Scheme)
+			hook := bwebhook.NewPodCPUBoostWebHook(manager, mockClient, scheme.Scheme)
 			response = hook.Handle(context.TODO(), admissionReq)
 		})
 		Describe("Webhook Admission Behavior", func() {
@@ -85,6 +100,10 @@ var _ = Describe("Pod CPU Boost Webhook", func() {
 				It("returns zero patches", func() {
 					Expect(response.Patches).To(HaveLen(0))
 				})
+				It("records the no match outcome", func() {
+					Expect(metrics.WebhookRequests(pod.Namespace, "", metrics.WebhookOutcomeNoMatch)).To(Equal(float64(1)))
+					Expect(metrics.WebhookDurationCount(pod.Namespace, "")).To(Equal(uint64(1)))
+				})
 			})
 			When("there is a matching Startup CPU Boost", func() {
 				var (
@@ -104,11 +123,12 @@ var _ = Describe("Pod CPU Boost Webhook", func() {
 							}
 							return p.Name == pod.Name && p.Namespace == pod.Namespace
 						}),
+						gomock.Any(),
 					)
 				})
 				When("ApplyResourcePolicy makes no changes", func() {
 					BeforeEach(func() {
-						applyResourcePolicyCall.Return(nil)
+						applyResourcePolicyCall.Return(cpuboost.ResourcePolicyResult{}, nil)
 					})
 					It("allows the admission", func() {
 						Expect(response.Allowed).To(BeTrue())
@@ -116,10 +136,17 @@ var _ = Describe("Pod CPU Boost Webhook", func() {
 					It("returns zero patches", func() {
 						Expect(response.Patches).To(HaveLen(0))
 					})
+					It("records the matched outcome only", func() {
+						Expect(metrics.WebhookRequests(pod.Namespace, "boost-one", metrics.WebhookOutcomeMatched)).
+							To(Equal(float64(1)))
+						Expect(metrics.WebhookRequests(pod.Namespace, "boost-one", metrics.WebhookOutcomeApplied)).
+							To(BeZero())
+					})
 				})
 				When("ApplyResourcePolicy mutates the pod", func() {
 					BeforeEach(func() {
-						applyResourcePolicyCall.DoAndReturn(func(ctx context.Context, p *corev1.Pod) error {
+						applyResourcePolicyCall.DoAndReturn(func(ctx context.Context,
+							p *corev1.Pod, overrides bpod.BoostOverrides) (cpuboost.ResourcePolicyResult, error) {
 							p.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU] = apiResource.MustParse("2")
 							if p.Annotations == nil {
 								p.Annotations = make(map[string]string)
@@ -130,7 +157,7 @@ var _ = Describe("Pod CPU Boost Webhook", func() {
 								p.Labels = make(map[string]string)
 							}
 							p.Labels[bpod.BoostLabelKey] = "boost-one"
-							return nil
+							return cpuboost.ResourcePolicyResult{}, nil
 						})
 					})
 					It("allows the admission", func() {
@@ -159,15 +186,150 @@ var _ = Describe("Pod CPU Boost Webhook", func() {
 							},
 						))
 					})
+					It("records the applied outcome and the patch size", func() {
+						Expect(metrics.WebhookRequests(pod.Namespace, "boost-one", metrics.WebhookOutcomeMatched)).
+							To(Equal(float64(1)))
+						Expect(metrics.WebhookRequests(pod.Namespace, "boost-one", metrics.WebhookOutcomeApplied)).
+							To(Equal(float64(1)))
+						Expect(metrics.WebhookPatchSizeCount(pod.Namespace, "boost-one")).To(Equal(uint64(1)))
+					})
+				})
+				When("ApplyResourcePolicy annotates the pod in the dry-run mode", func() {
+					BeforeEach(func() {
+						applyResourcePolicyCall.DoAndReturn(func(ctx context.Context,
+							p *corev1.Pod, overrides bpod.BoostOverrides) (cpuboost.ResourcePolicyResult, error) {
+							if p.Annotations == nil {
+								p.Annotations = make(map[string]string)
+							}
+							p.Annotations[bpod.DryRunAnnotationKey] = `{"boostName": "boost-one"}`
+							return cpuboost.ResourcePolicyResult{DryRun: true}, nil
+						})
+					})
+					It("returns the annotation patch only", func() {
+						Expect(response.Patches).To(ConsistOf(
+							jsonpatch.Operation{
+								Operation: "add",
+								Path:      "/metadata/annotations",
+								Value: map[string]interface{}{
+									bpod.DryRunAnnotationKey: `{"boostName": "boost-one"}`,
+								},
+							},
+						))
+					})
+					It("records the dry-run outcome", func() {
+						Expect(metrics.WebhookRequests(pod.Namespace, "boost-one", metrics.WebhookOutcomeDryRun)).
+							To(Equal(float64(1)))
+						Expect(metrics.WebhookRequests(pod.Namespace, "boost-one", metrics.WebhookOutcomeApplied)).
+							To(BeZero())
+					})
+				})
+				When("ApplyResourcePolicy skips containers", func() {
+					BeforeEach(func() {
+						applyResourcePolicyCall.Return(cpuboost.ResourcePolicyResult{
+							SkippedContainers: []cpuboost.SkippedContainer{
+								{
+									Name:    "app",
+									Reason:  cpuboost.EventReasonSkippedQoSChange,
+									Message: "QoS would change from Guaranteed to Burstable",
+								},
+								{
+									Name:    "sidecar",
+									Reason:  cpuboost.EventReasonSkippedNoCPUResources,
+									Message: "container has no CPU resources to increase",
+								},
+							},
+						}, nil)
+					})
+					It("allows the admission", func() {
+						Expect(response.Allowed).To(BeTrue())
+					})
+					It("returns the warnings per container", func() {
+						Expect(response.Warnings).To(Equal([]string{
+							"container app not boosted: QoS would change from Guaranteed to Burstable",
+							"container sidecar not boosted: container has no CPU resources to increase",
+						}))
+					})
+					It("returns the audit annotation with the summary", func() {
+						Expect(response.AuditAnnotations).To(HaveKeyWithValue(
+							bwebhook.SkippedContainersAuditAnnotationKey,
+							"app: QoS would change from Guaranteed to Burstable; "+
+								"sidecar: container has no CPU resources to increase"))
+					})
+				})
+				When("ApplyResourcePolicy returns warnings", func() {
+					BeforeEach(func() {
+						applyResourcePolicyCall.Return(cpuboost.ResourcePolicyResult{
+							Warnings: []string{"percentage override 500 is ignored as it is outside of the allowed range [20, 200]"},
+						}, nil)
+					})
+					It("returns the warnings", func() {
+						Expect(response.Allowed).To(BeTrue())
+						Expect(response.Warnings).To(Equal([]string{
+							"percentage override 500 is ignored as it is outside of the allowed range [20, 200]",
+						}))
+					})
+				})
+				When("the POD has the annotation disabling the boost", func() {
+					BeforeEach(func() {
+						pod.Annotations = map[string]string{bpod.DisabledAnnotationKey: "true"}
+						applyResourcePolicyCall.Times(0)
+					})
+					It("allows the admission", func() {
+						Expect(response.Allowed).To(BeTrue())
+					})
+					It("returns zero patches", func() {
+						Expect(response.Patches).To(HaveLen(0))
+					})
+				})
+				When("the namespace has the annotation disabling the boost", func() {
+					BeforeEach(func() {
+						namespaceAnnotations = map[string]string{bpod.DisabledAnnotationKey: "true"}
+						applyResourcePolicyCall.Times(0)
+					})
+					It("allows the admission", func() {
+						Expect(response.Allowed).To(BeTrue())
+					})
+					It("returns zero patches", func() {
+						Expect(response.Patches).To(HaveLen(0))
+					})
+				})
+				When("the POD and namespace have the annotations overriding the boost", func() {
+					var overrides bpod.BoostOverrides
+					BeforeEach(func() {
+						namespaceAnnotations = map[string]string{
+							bpod.DisabledAnnotationKey:   "true",
+							bpod.PercentageAnnotationKey: "80",
+						}
+						pod.Annotations = map[string]string{
+							bpod.DisabledAnnotationKey: "false",
+							bpod.DurationAnnotationKey: "90s",
+						}
+						applyResourcePolicyCall.DoAndReturn(func(ctx context.Context,
+							p *corev1.Pod, o bpod.BoostOverrides) (cpuboost.ResourcePolicyResult, error) {
+							overrides = o
+							return cpuboost.ResourcePolicyResult{}, nil
+						})
+					})
+					It("applies the resource policy with the merged overrides", func() {
+						Expect(overrides.Disabled).To(BeFalse())
+						Expect(overrides.Percentage).To(HaveValue(Equal(int64(80))))
+						Expect(overrides.Duration).To(HaveValue(Equal(90 * time.Second)))
+					})
 				})
 				When("ApplyResourcePolicy returns an error", func() {
 					BeforeEach(func() {
-						applyResourcePolicyCall.Return(fmt.Errorf("internal policy error"))
+						applyResourcePolicyCall.Return(cpuboost.ResourcePolicyResult{}, fmt.Errorf("internal policy error"))
 					})
 					It("denies the admission with the error", func() {
 						Expect(response.Allowed).To(BeFalse())
 						Expect(response.Result.Message).To(ContainSubstring("internal policy error"))
 					})
+					It("records the errored outcome", func() {
+						Expect(metrics.WebhookRequests(pod.Namespace, "boost-one", metrics.WebhookOutcomeErrored)).
+							To(Equal(float64(1)))
+						Expect(metrics.WebhookRequests(pod.Namespace, "boost-one", metrics.WebhookOutcomeApplied)).
+							To(BeZero())
+					})
 				})
 			})
 		})
@@ -19,69 +19,398 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"regexp/syntax"
+	"slices"
+	"unicode"
 
-	"github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	"github.com/google/kube-startup-cpu-boost/api/v1beta1"
+	"github.com/google/kube-startup-cpu-boost/internal/boost"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-type StartupCPUBoostWebhook struct{}
+var (
+	// knownPodConditionTypes are the POD condition types set by the kubernetes components
+	knownPodConditionTypes = sets.New(corev1.PodScheduled, corev1.PodReadyToStartContainers,
+		corev1.PodInitialized, corev1.ContainersReady, corev1.PodReady, corev1.DisruptionTarget,
+		corev1.PodResizePending, corev1.PodResizeInProgress, corev1.AllContainersRestarting)
+	podConditionStatuses = []corev1.ConditionStatus{corev1.ConditionTrue, corev1.ConditionFalse,
+		corev1.ConditionUnknown}
+	profileKinds = []v1beta1.ProfileKind{v1beta1.ProfileKindNamespaced, v1beta1.ProfileKindCluster}
+	// containerNameRuneRanges are the ranges of characters allowed in container names
+	containerNameRuneRanges = [][2]rune{{'a', 'z'}, {'0', '9'}, {'-', '-'}}
+	// imageTagRegexp and imageDigestRegexp follow the OCI distribution specification
+	imageTagRegexp    = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`)
+	imageDigestRegexp = regexp.MustCompile(`^[a-z0-9]+(?:[.+_-][a-z0-9]+)*:[a-zA-Z0-9=_-]+$`)
+)
+
+type StartupCPUBoostWebhook struct {
+	// Client reads the existing boosts to warn about overlapping selectors.
+	// The overlap is not verified when nil.
+	Client client.Reader
+}
 
-var _ admission.Validator[*v1alpha1.StartupCPUBoost] = &StartupCPUBoostWebhook{}
+var _ admission.Validator[*v1beta1.StartupCPUBoost] = &StartupCPUBoostWebhook{}
+var _ admission.Defaulter[*v1beta1.StartupCPUBoost] = &StartupCPUBoostWebhook{}
 
 func setupWebhookForStartupCPUBoost(mgr ctrl.Manager) error {
-	return ctrl.NewWebhookManagedBy(mgr, &v1alpha1.StartupCPUBoost{}).
-		WithValidator(&StartupCPUBoostWebhook{}).
+	boostWebhook := &StartupCPUBoostWebhook{Client: mgr.GetClient()}
+	return ctrl.NewWebhookManagedBy(mgr, &v1beta1.StartupCPUBoost{}).
+		WithValidator(boostWebhook).
+		WithDefaulter(boostWebhook).
 		Complete()
 }
 
-// +kubebuilder:webhook:path=/validate-autoscaling-x-k8s-io-v1alpha1-startupcpuboost,mutating=false,failurePolicy=fail,sideEffects=None,groups=autoscaling.x-k8s.io,resources=startupcpuboosts,verbs=create;update,versions=v1alpha1,name=vstartupcpuboost.autoscaling.x-k8s.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-autoscaling-x-k8s-io-v1beta1-startupcpuboost,mutating=true,failurePolicy=fail,sideEffects=None,groups=autoscaling.x-k8s.io,resources=startupcpuboosts,verbs=create;update,versions=v1beta1,name=mstartupcpuboost.autoscaling.x-k8s.io,admissionReviewVersions=v1
+
+// Default implements admission.Defaulter so a webhook will be registered for the type
+func (w *StartupCPUBoostWebhook) Default(ctx context.Context, boost *v1beta1.StartupCPUBoost) error {
+	log := ctrl.LoggerFrom(ctx).WithName("boost-default-webhook")
+	log.V(5).Info("handling defaulting", "boost", klog.KObj(boost))
+	if fixed := boost.Spec.DurationPolicy.Fixed; fixed != nil && fixed.Unit == "" {
+		fixed.Unit = v1beta1.FixedDurationPolicyUnitSec
+	}
+	if boost.Spec.DriftPolicy == "" {
+		boost.Spec.DriftPolicy = v1beta1.DriftPolicySkip
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-autoscaling-x-k8s-io-v1beta1-startupcpuboost,mutating=false,failurePolicy=fail,sideEffects=None,groups=autoscaling.x-k8s.io,resources=startupcpuboosts,verbs=create;update,versions=v1beta1,name=vstartupcpuboost.autoscaling.x-k8s.io,admissionReviewVersions=v1
 
 // ValidateCreate implements admission.Validator so a webhook will be registered for the type
-func (w *StartupCPUBoostWebhook) ValidateCreate(ctx context.Context, boost *v1alpha1.StartupCPUBoost) (admission.Warnings, error) {
+func (w *StartupCPUBoostWebhook) ValidateCreate(ctx context.Context, boost *v1beta1.StartupCPUBoost) (admission.Warnings, error) {
 	log := ctrl.LoggerFrom(ctx).WithName("boost-validate-webhook")
 	log.V(5).Info("handling create validation", "boost", klog.KObj(boost))
-	return validateWarnings(boost), validate(boost)
+	return append(validateWarnings(boost), w.overlapWarnings(ctx, boost)...), validate(boost)
 }
 
 // ValidateUpdate implements admission.Validator so a webhook will be registered for the type
-func (w *StartupCPUBoostWebhook) ValidateUpdate(ctx context.Context, oldObj, boost *v1alpha1.StartupCPUBoost) (admission.Warnings, error) {
+func (w *StartupCPUBoostWebhook) ValidateUpdate(ctx context.Context, oldObj, boost *v1beta1.StartupCPUBoost) (admission.Warnings, error) {
 	log := ctrl.LoggerFrom(ctx).WithName("boost-validate-webhook")
 	log.V(5).Info("handling update validation", "startupcpuboost", klog.KObj(boost))
-	return validateWarnings(boost), validate(boost)
+	return append(validateWarnings(boost), w.overlapWarnings(ctx, boost)...), validate(boost)
 }
 
 // ValidateDelete implements admission.Validator so a webhook will be registered for the type
-func (w *StartupCPUBoostWebhook) ValidateDelete(ctx context.Context, obj *v1alpha1.StartupCPUBoost) (admission.Warnings, error) {
+func (w *StartupCPUBoostWebhook) ValidateDelete(ctx context.Context, obj *v1beta1.StartupCPUBoost) (admission.Warnings, error) {
 	return nil, nil
 }
 
-func validateWarnings(boost *v1alpha1.StartupCPUBoost) admission.Warnings {
+// overlapWarnings returns warnings for the boosts in the namespace which selectors or
+// target references overlap with the ones of a given boost, as only one of them is
+// applied to a POD
+func (w *StartupCPUBoostWebhook) overlapWarnings(ctx context.Context,
+	boostObj *v1beta1.StartupCPUBoost) admission.Warnings {
+	if w.Client == nil {
+		return nil
+	}
+	log := ctrl.LoggerFrom(ctx).WithName("boost-validate-webhook")
+	selector, err := metav1.LabelSelectorAsSelector(&boostObj.Spec.Selector)
+	if err != nil {
+		return nil
+	}
+	var boostList v1beta1.StartupCPUBoostList
+	if err := w.Client.List(ctx, &boostList, client.InNamespace(boostObj.Namespace)); err != nil {
+		log.Error(err, "failed to list boosts for selector overlap verification")
+		return nil
+	}
+	var warnings admission.Warnings
+	for _, other := range boostList.Items {
+		if other.Name == boostObj.Name {
+			continue
+		}
+		otherSelector, err := metav1.LabelSelectorAsSelector(&other.Spec.Selector)
+		if err != nil || !boost.TargetsOverlap(selector, boostObj.Spec.TargetRef, otherSelector, other.Spec.TargetRef) {
+			continue
+		}
+		applied := other.Name
+		if boostObj.Spec.Priority > other.Spec.Priority ||
+			(boostObj.Spec.Priority == other.Spec.Priority && boostObj.Name < other.Name) {
+			applied = boostObj.Name
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"selector overlaps with StartupCPUBoost %s (priority %d); %s is applied to PODs matched by both",
+			other.Name, other.Spec.Priority, applied,
+		))
+	}
+	return warnings
+}
+
+// validateWarnings returns warnings for the Startup CPU Boost configuration that
+// is valid but most likely does not work as intended
+func validateWarnings(boost *v1beta1.StartupCPUBoost) admission.Warnings {
+	var warnings admission.Warnings
+	selector := boost.Spec.Selector
+	if boost.Spec.TargetRef == nil && len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+		warnings = append(warnings, "spec.selector is empty and matches all PODs in the namespace")
+	}
+	if condition := boost.Spec.DurationPolicy.PodCondition; condition != nil && condition.Type != "" &&
+		!knownPodConditionTypes.Has(condition.Type) {
+		warnings = append(warnings, fmt.Sprintf(
+			"spec.durationPolicy.podCondition.type %q is not a known POD condition type; "+
+				"the resources are reverted only if the condition is set on the POD, i.e. by a readiness gate",
+			condition.Type,
+		))
+	}
+	// the policies of the referenced profile are not known here, so the overrides
+	// are verified only against the boost policies
+	if overrides := boost.Spec.Overrides; overrides != nil && boost.Spec.ProfileRef == nil {
+		if overrides.Duration != nil && boost.Spec.DurationPolicy.Fixed == nil {
+			warnings = append(warnings, "spec.overrides.duration has no effect without the fixed duration policy")
+		}
+		if overrides.Percentage != nil && !slices.ContainsFunc(boost.Spec.ResourcePolicy.ContainerPolicies,
+			func(policy v1beta1.ContainerPolicy) bool { return policy.PercentageIncrease != nil }) {
+			warnings = append(warnings,
+				"spec.overrides.percentage has no effect without the percentage increase resource policy")
+		}
+	}
+	warnings = append(warnings, containerMatcherWarnings(boost.Spec.ResourcePolicy.ContainerPolicies)...)
+	return warnings
+}
+
+// containerMatcherWarnings returns warnings for the container matchers that do not
+// match any valid container name or are shadowed by the former matchers, as the
+// first matching container policy is used
+func containerMatcherWarnings(policies []v1beta1.ContainerPolicy) admission.Warnings {
 	var warnings admission.Warnings
-	for i, policy := range boost.Spec.ResourcePolicy.ContainerPolicies {
-		//lint:ignore SA1019 backwards-compatible support for deprecated ContainerName
-		if policy.ContainerName != "" {
+	baseFldPath := field.NewPath("spec").
+		Child("resourcePolicy").
+		Child("containerPolicies")
+	for i, policy := range policies {
+		matcher := policy.MatchContainers
+		if matcher == nil {
+			continue
+		}
+		fldPath := baseFldPath.Index(i).Child("matchContainers")
+		if matcher.Type == v1beta1.MatchContainersTypeComposite {
+			for k, rule := range matcher.Include {
+				warnings = append(warnings, matchContainersRuleWarnings(rule, fldPath.Child("include").Index(k))...)
+			}
+			for k, rule := range matcher.Exclude {
+				warnings = append(warnings, matchContainersRuleWarnings(rule, fldPath.Child("exclude").Index(k))...)
+			}
+		} else if ruleWarnings := matchContainersRuleWarnings(matchContainersRule(matcher), fldPath); len(ruleWarnings) > 0 {
+			warnings = append(warnings, ruleWarnings...)
+			continue
+		}
+		if j := shadowingPolicy(policies, i); j >= 0 {
 			warnings = append(warnings, fmt.Sprintf(
-				"spec.resourcePolicy.containerPolicies[%d].containerName is deprecated; use matchContainers with type=ExactName instead", i,
-			))
+				"%s is shadowed by %s; the policy is never used as the first matching policy applies",
+				fldPath, baseFldPath.Index(j)))
 		}
 	}
 	return warnings
 }
 
+// matchContainersRuleWarnings returns a warning when a given rule does not match any
+// valid container name
+func matchContainersRuleWarnings(rule v1beta1.MatchContainersRule, fldPath *field.Path) admission.Warnings {
+	switch rule.Type {
+	case v1beta1.MatchContainersTypeExactName:
+		if errs := validation.IsDNS1123Label(rule.Value); len(errs) > 0 {
+			return admission.Warnings{fmt.Sprintf(
+				"%s value %q is not a valid container name and matches no container", fldPath, rule.Value)}
+		}
+	case v1beta1.MatchContainersTypeRegexName:
+		if re, err := syntax.Parse(rule.Value, syntax.Perl); err == nil && !matchesContainerNames(re) {
+			return admission.Warnings{fmt.Sprintf(
+				"%s regular expression %q matches no valid container name", fldPath, rule.Value)}
+		}
+	}
+	return nil
+}
+
+// matchContainersRule returns the single rule of a given non-composite container matcher
+func matchContainersRule(matcher *v1beta1.MatchContainers) v1beta1.MatchContainersRule {
+	return v1beta1.MatchContainersRule{
+		Type:   matcher.Type,
+		Value:  matcher.Value,
+		Tag:    matcher.Tag,
+		Digest: matcher.Digest,
+	}
+}
+
+// shadowingPolicy returns the index of the former container policy that matches all the
+// containers matched by the policy with a given index, or -1 if there is no such policy.
+// The former policy with POD matching rules shadows only the policy with the same rules.
+func shadowingPolicy(policies []v1beta1.ContainerPolicy, idx int) int {
+	matcher := policies[idx].MatchContainers
+	for i := range idx {
+		former := policies[i].MatchContainers
+		if former == nil {
+			continue
+		}
+		if policies[i].MatchPod != nil && !equality.Semantic.DeepEqual(policies[i].MatchPod, policies[idx].MatchPod) {
+			continue
+		}
+		if equality.Semantic.DeepEqual(former, matcher) || matchesAllContainers(former) {
+			return i
+		}
+		if former.Type != v1beta1.MatchContainersTypeRegexName {
+			continue
+		}
+		re, err := regexp.Compile(former.Value)
+		if err != nil {
+			continue
+		}
+		if matcher.Type == v1beta1.MatchContainersTypeExactName && re.MatchString(matcher.Value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// matchesAllContainers determines if a given container matcher matches any container,
+// i.e. the catch-all regular expression or the Composite matcher without exclude rules
+// that has no include rules or includes the catch-all regular expression.
+func matchesAllContainers(matcher *v1beta1.MatchContainers) bool {
+	switch matcher.Type {
+	case v1beta1.MatchContainersTypeRegexName:
+		return matchesAnyName(matcher.Value)
+	case v1beta1.MatchContainersTypeComposite:
+		if len(matcher.Exclude) > 0 {
+			return false
+		}
+		return len(matcher.Include) == 0 || slices.ContainsFunc(matcher.Include, func(rule v1beta1.MatchContainersRule) bool {
+			return rule.Type == v1beta1.MatchContainersTypeRegexName && matchesAnyName(rule.Value)
+		})
+	}
+	return false
+}
+
+// matchesAnyName determines if a given regular expression matches any container name.
+// The expression anchored at one end at most matches any name when it matches the empty
+// string. The expression anchored at both ends matches any name when it matches any
+// sequence of characters, i.e. ^.*$ or ^.+$. Other empty-width assertions are not supported.
+func matchesAnyName(expr string) bool {
+	parsed, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return false
+	}
+	subs := []*syntax.Regexp{parsed}
+	if parsed.Op == syntax.OpConcat {
+		subs = parsed.Sub
+	}
+	begin := len(subs) > 0 && (subs[0].Op == syntax.OpBeginText || subs[0].Op == syntax.OpBeginLine)
+	if begin {
+		subs = subs[1:]
+	}
+	end := len(subs) > 0 && (subs[len(subs)-1].Op == syntax.OpEndText || subs[len(subs)-1].Op == syntax.OpEndLine)
+	if end {
+		subs = subs[:len(subs)-1]
+	}
+	if slices.ContainsFunc(subs, hasEmptyWidthAssertion) {
+		return false
+	}
+	if begin && end {
+		return len(subs) == 1 && matchesAnySequence(subs[0])
+	}
+	rest := &syntax.Regexp{Op: syntax.OpConcat, Sub: subs}
+	re, err := regexp.Compile(rest.String())
+	return err == nil && re.MatchString("")
+}
+
+// matchesAnySequence determines if a given regular expression is a repetition of any
+// character, optionally captured
+func matchesAnySequence(re *syntax.Regexp) bool {
+	for re.Op == syntax.OpCapture {
+		re = re.Sub[0]
+	}
+	if re.Op != syntax.OpStar && re.Op != syntax.OpPlus {
+		return false
+	}
+	return re.Sub[0].Op == syntax.OpAnyChar || re.Sub[0].Op == syntax.OpAnyCharNotNL
+}
+
+func hasEmptyWidthAssertion(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return true
+	}
+	return slices.ContainsFunc(re.Sub, hasEmptyWidthAssertion)
+}
+
+// matchesContainerNames determines if a given regular expression can match a string
+// built of the characters allowed in container names, i.e. lower case alphanumeric
+// characters and '-'. Empty-width assertions are considered as satisfiable.
+func matchesContainerNames(re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return false
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if !isContainerNameRune(r) && (re.Flags&syntax.FoldCase == 0 || !isContainerNameRune(unicode.ToLower(r))) {
+				return false
+			}
+		}
+		return true
+	case syntax.OpCharClass:
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			for _, allowed := range containerNameRuneRanges {
+				if re.Rune[i] <= allowed[1] && re.Rune[i+1] >= allowed[0] {
+					return true
+				}
+			}
+		}
+		return false
+	case syntax.OpCapture, syntax.OpPlus:
+		return matchesContainerNames(re.Sub[0])
+	case syntax.OpRepeat:
+		return re.Min == 0 || matchesContainerNames(re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !matchesContainerNames(sub) {
+				return false
+			}
+		}
+		return true
+	case syntax.OpAlternate:
+		return slices.ContainsFunc(re.Sub, matchesContainerNames)
+	default:
+		return true
+	}
+}
+
+func isContainerNameRune(r rune) bool {
+	for _, allowed := range containerNameRuneRanges {
+		if r >= allowed[0] && r <= allowed[1] {
+			return true
+		}
+	}
+	return false
+}
+
 // validate verifies if Startup CPU Boost is valid. This is programmatic
 // validation on a top of declarative API validation
-func validate(boost *v1alpha1.StartupCPUBoost) error {
+func validate(boost *v1beta1.StartupCPUBoost) error {
 	var allErrs field.ErrorList
+	if errs := validateTargetRef(boost.Spec); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 	if errs := validateContainerPolicies(boost.Spec.ResourcePolicy.ContainerPolicies); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
-	if err := validateDurationPolicy(boost.Spec.DurationPolicy); err != nil {
-		allErrs = append(allErrs, err)
+	if errs := validateDurationPolicy(boost.Spec.DurationPolicy, boost.Spec.ProfileRef != nil); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := validateOverridePolicy(boost.Spec.Overrides); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+	if errs := validateProfileRef(boost.Spec.ProfileRef); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
 	}
 	if len(allErrs) > 0 {
 		return apierrors.NewInvalid(
@@ -91,23 +420,104 @@ func validate(boost *v1alpha1.StartupCPUBoost) error {
 	return nil
 }
 
-func validateDurationPolicy(policy v1alpha1.DurationPolicy) *field.Error {
+func validateTargetRef(spec v1beta1.StartupCPUBoostSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	targetRef := spec.TargetRef
+	if targetRef == nil {
+		return allErrs
+	}
+	fldPath := field.NewPath("spec").Child("targetRef")
+	if len(spec.Selector.MatchLabels) > 0 || len(spec.Selector.MatchExpressions) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("selector"),
+			"selector should be empty when targetRef is set"))
+	}
+	if _, err := schema.ParseGroupVersion(targetRef.APIVersion); err != nil || targetRef.APIVersion == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("apiVersion"), targetRef.APIVersion,
+			"apiVersion should be a valid group version, i.e. apps/v1"))
+	}
+	if targetRef.Kind == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kind"), "kind should be defined"))
+	}
+	if targetRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name should be defined"))
+	}
+	return allErrs
+}
+
+func validateDurationPolicy(policy v1beta1.DurationPolicy, hasProfile bool) field.ErrorList {
+	var allErrs field.ErrorList
 	var cnt int
-	fldPath := field.NewPath("spec").Child("")
-	if policy.Fixed != nil {
+	fldPath := field.NewPath("spec").Child("durationPolicy")
+	if fixed := policy.Fixed; fixed != nil {
 		cnt++
+		if fixed.Value < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("fixedDuration", "value"),
+				fixed.Value, "duration should be greater than zero"))
+		}
 	}
-	if policy.PodCondition != nil {
+	if condition := policy.PodCondition; condition != nil {
 		cnt++
+		if condition.Type == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("podCondition", "type"),
+				"POD condition type should be defined"))
+		}
+		if !slices.Contains(podConditionStatuses, condition.Status) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("podCondition", "status"),
+				condition.Status, podConditionStatuses))
+		}
 	}
-	if cnt == 0 {
+	if cnt == 0 && !hasProfile {
 		err := errors.New("at least one duration policy should be defined")
-		return field.Invalid(fldPath, policy, err.Error())
+		allErrs = append(allErrs, field.Invalid(fldPath, policy, err.Error()))
 	}
-	return nil
+	return allErrs
 }
 
-func validateContainerPolicies(policies []v1alpha1.ContainerPolicy) field.ErrorList {
+func validateOverridePolicy(policy *v1beta1.OverridePolicy) field.ErrorList {
+	var allErrs field.ErrorList
+	if policy == nil {
+		return allErrs
+	}
+	fldPath := field.NewPath("spec").Child("overrides")
+	if percentage := policy.Percentage; percentage != nil {
+		if percentage.Min < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("percentage", "min"),
+				percentage.Min, "percentage should be greater than zero"))
+		}
+		if percentage.Max < percentage.Min {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("percentage", "max"),
+				percentage.Max, "max should not be less than min"))
+		}
+	}
+	if duration := policy.Duration; duration != nil {
+		if duration.Min < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("duration", "min"),
+				duration.Min, "duration should be greater than zero"))
+		}
+		if duration.Max < duration.Min {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("duration", "max"),
+				duration.Max, "max should not be less than min"))
+		}
+	}
+	return allErrs
+}
+
+func validateProfileRef(ref *v1beta1.ProfileReference) field.ErrorList {
+	var allErrs field.ErrorList
+	if ref == nil {
+		return allErrs
+	}
+	fldPath := field.NewPath("spec").Child("profileRef")
+	if ref.Kind != "" && !slices.Contains(profileKinds, ref.Kind) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kind"), ref.Kind, profileKinds))
+	}
+	if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name should be defined"))
+	}
+	return allErrs
+}
+
+func validateContainerPolicies(policies []v1beta1.ContainerPolicy) field.ErrorList {
 	var allErrs field.ErrorList
 	baseFldPath := field.NewPath("spec").
 		Child("resourcePolicy").
@@ -117,14 +527,48 @@ func validateContainerPolicies(policies []v1alpha1.ContainerPolicy) field.ErrorL
 		if errs := validateContainerPolicyTypes(policies[i], fldPath); len(errs) > 0 {
 			allErrs = append(allErrs, errs...)
 		}
+		if errs := validateContainerPolicyResources(policies[i], fldPath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		}
 		if errs := validateContainerPolicyMatchers(policies[i], fldPath); len(errs) > 0 {
 			allErrs = append(allErrs, errs...)
 		}
+		if errs := validateContainerPolicyPodMatcher(policies[i], fldPath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+		}
+	}
+	return allErrs
+}
+
+func validateContainerPolicyPodMatcher(policy v1beta1.ContainerPolicy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	matcher := policy.MatchPod
+	if matcher == nil {
+		return allErrs
+	}
+	fldPath = fldPath.Child("matchPod")
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(matcher.Selector,
+		metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("selector"))...)
+	for i, name := range matcher.PriorityClassNames {
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("priorityClassNames").Index(i), name, msg))
+		}
+	}
+	for i, kind := range matcher.OwnerKinds {
+		if kind == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("ownerKinds").Index(i),
+				"owner kind should not be empty"))
+		}
+	}
+	for i, key := range matcher.NodeSelectorKeys {
+		for _, msg := range validation.IsQualifiedName(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeSelectorKeys").Index(i), key, msg))
+		}
 	}
 	return allErrs
 }
 
-func validateContainerPolicyTypes(policy v1alpha1.ContainerPolicy, fldPath *field.Path) field.ErrorList {
+func validateContainerPolicyTypes(policy v1beta1.ContainerPolicy, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	var cnt int
 	if policy.FixedResources != nil {
@@ -142,29 +586,109 @@ func validateContainerPolicyTypes(policy v1alpha1.ContainerPolicy, fldPath *fiel
 	return allErrs
 }
 
-func validateContainerPolicyMatchers(policy v1alpha1.ContainerPolicy, fldPath *field.Path) field.ErrorList {
+func validateContainerPolicyResources(policy v1beta1.ContainerPolicy, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
-	var cnt int
-	//lint:ignore SA1019 backwards-compatible support for deprecated ContainerName
-	if policy.ContainerName != "" {
-		cnt++
-	}
-	if policy.MatchContainers != nil {
-		cnt++
-		if policy.MatchContainers.Type == v1alpha1.MatchContainersTypeRegexName {
-			if _, err := regexp.Compile(policy.MatchContainers.Value); err != nil {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("matchContainers", "value"),
-					policy.MatchContainers.Value,
-					fmt.Sprintf("invalid regular expression: %s", err),
-				))
-			}
+	if fixed := policy.FixedResources; fixed != nil {
+		fixedPath := fldPath.Child("fixedResources")
+		if fixed.Requests.Sign() <= 0 {
+			allErrs = append(allErrs, field.Invalid(fixedPath.Child("requests"),
+				fixed.Requests.String(), "requests should be greater than zero"))
+		}
+		if fixed.Limits.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fixedPath.Child("limits"),
+				fixed.Limits.String(), "limits should not be negative"))
+		}
+		if !fixed.Limits.IsZero() && fixed.Limits.Cmp(fixed.Requests) < 0 {
+			allErrs = append(allErrs, field.Invalid(fixedPath.Child("limits"),
+				fixed.Limits.String(), "limits should not be lower than requests"))
 		}
 	}
-	if cnt != 1 {
-		allErrs = append(allErrs, field.Invalid(fldPath,
-			policy,
-			"either container name or container matcher should be defined",
+	if percentage := policy.PercentageIncrease; percentage != nil && percentage.Value < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("percentageIncrease", "value"),
+			percentage.Value, "percentage should be greater than zero"))
+	}
+	return allErrs
+}
+
+func validateContainerPolicyMatchers(policy v1beta1.ContainerPolicy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if policy.MatchContainers == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("matchContainers"),
+			"container matcher should be defined",
 		))
+		return allErrs
+	}
+	matcher, matcherPath := policy.MatchContainers, fldPath.Child("matchContainers")
+	if matcher.Type != v1beta1.MatchContainersTypeComposite {
+		if len(matcher.Include) > 0 {
+			allErrs = append(allErrs, field.Forbidden(matcherPath.Child("include"),
+				"include is supported only by the Composite container matcher"))
+		}
+		if len(matcher.Exclude) > 0 {
+			allErrs = append(allErrs, field.Forbidden(matcherPath.Child("exclude"),
+				"exclude is supported only by the Composite container matcher"))
+		}
+		return append(allErrs, validateMatchContainersRule(matchContainersRule(matcher), matcherPath)...)
+	}
+	if matcher.Value != "" {
+		allErrs = append(allErrs, field.Forbidden(matcherPath.Child("value"),
+			"value is not supported by the Composite container matcher"))
+	}
+	if matcher.Tag != "" || matcher.Digest != "" {
+		allErrs = append(allErrs, field.Forbidden(matcherPath,
+			"tag and digest are not supported by the Composite container matcher"))
+	}
+	if len(matcher.Include) == 0 && len(matcher.Exclude) == 0 {
+		allErrs = append(allErrs, field.Required(matcherPath,
+			"composite container matcher should define include or exclude rules"))
+	}
+	for i, rule := range matcher.Include {
+		allErrs = append(allErrs, validateMatchContainersRule(rule, matcherPath.Child("include").Index(i))...)
+	}
+	for i, rule := range matcher.Exclude {
+		allErrs = append(allErrs, validateMatchContainersRule(rule, matcherPath.Child("exclude").Index(i))...)
+	}
+	return allErrs
+}
+
+func validateMatchContainersRule(rule v1beta1.MatchContainersRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	switch rule.Type {
+	case v1beta1.MatchContainersTypeExactName:
+	case v1beta1.MatchContainersTypeRegexName, v1beta1.MatchContainersTypeImage:
+		if _, err := regexp.Compile(rule.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("value"),
+				rule.Value,
+				fmt.Sprintf("invalid regular expression: %s", err),
+			))
+		}
+	default:
+		return append(allErrs, field.NotSupported(fldPath.Child("type"), rule.Type,
+			[]v1beta1.MatchContainersType{v1beta1.MatchContainersTypeExactName,
+				v1beta1.MatchContainersTypeRegexName, v1beta1.MatchContainersTypeImage}))
+	}
+	if rule.Value == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("value"),
+			"container matcher value should be defined"))
+	}
+	if rule.Type != v1beta1.MatchContainersTypeImage {
+		if rule.Tag != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("tag"),
+				"tag is supported only by the Image container matcher"))
+		}
+		if rule.Digest != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("digest"),
+				"digest is supported only by the Image container matcher"))
+		}
+		return allErrs
+	}
+	if rule.Tag != "" && !imageTagRegexp.MatchString(rule.Tag) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tag"),
+			rule.Tag, "invalid image tag"))
+	}
+	if rule.Digest != "" && !imageDigestRegexp.MatchString(rule.Digest) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("digest"),
+			rule.Digest, "invalid image digest, i.e. sha256:<hex>"))
 	}
 	return allErrs
 }
@@ -17,10 +17,17 @@ package webhook_test
 import (
 	"context"
 
-	"github.com/google/kube-startup-cpu-boost/api/v1alpha1"
+	"github.com/google/kube-startup-cpu-boost/api/v1beta1"
+	"github.com/google/kube-startup-cpu-boost/internal/mock"
 	"github.com/google/kube-startup-cpu-boost/internal/webhook"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("StartupCPUBoost webhook", func() {
@@ -29,16 +36,73 @@ var _ = Describe("StartupCPUBoost webhook", func() {
 		w = webhook.StartupCPUBoostWebhook{}
 	})
 
+	When("Defaults StartupCPUBoost", func() {
+		var (
+			boost v1beta1.StartupCPUBoost
+			err   error
+		)
+		BeforeEach(func() {
+			boost = v1beta1.StartupCPUBoost{
+				Spec: v1beta1.StartupCPUBoostSpec{
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "demo"},
+					},
+					ResourcePolicy: v1beta1.ResourcePolicy{
+						ContainerPolicies: []v1beta1.ContainerPolicy{
+							{
+								MatchContainers: &v1beta1.MatchContainers{
+									Type:  v1beta1.MatchContainersTypeRegexName,
+									Value: "^container-.*$",
+								},
+								PercentageIncrease: &v1beta1.PercentageIncrease{Value: 50},
+							},
+						},
+					},
+					DurationPolicy: v1beta1.DurationPolicy{
+						Fixed: &v1beta1.FixedDurationPolicy{Value: 30},
+					},
+				},
+			}
+		})
+		JustBeforeEach(func() {
+			err = w.Default(context.TODO(), &boost)
+		})
+		It("does not error", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("defaults the fixed duration unit", func() {
+			Expect(boost.Spec.DurationPolicy.Fixed.Unit).To(Equal(v1beta1.FixedDurationPolicyUnitSec))
+		})
+		It("defaults the drift policy", func() {
+			Expect(boost.Spec.DriftPolicy).To(Equal(v1beta1.DriftPolicySkip))
+		})
+		It("passes the validation without warnings", func() {
+			warnings, err := w.ValidateCreate(context.TODO(), &boost)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+		When("duration unit and drift policy are set", func() {
+			BeforeEach(func() {
+				boost.Spec.DurationPolicy.Fixed.Unit = v1beta1.FixedDurationPolicyUnitMin
+				boost.Spec.DriftPolicy = v1beta1.DriftPolicyDelta
+			})
+			It("does not change them", func() {
+				Expect(boost.Spec.DurationPolicy.Fixed.Unit).To(Equal(v1beta1.FixedDurationPolicyUnitMin))
+				Expect(boost.Spec.DriftPolicy).To(Equal(v1beta1.DriftPolicyDelta))
+			})
+		})
+	})
+
 	When("Validates StartupCPUBoost", func() {
 		var (
-			boost v1alpha1.StartupCPUBoost
+			boost v1beta1.StartupCPUBoost
 			err   error
 		)
 		When("Startup CPU Boost has no duration policy", func() {
 			BeforeEach(func() {
-				boost = v1alpha1.StartupCPUBoost{
-					Spec: v1alpha1.StartupCPUBoostSpec{
-						DurationPolicy: v1alpha1.DurationPolicy{},
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						DurationPolicy: v1beta1.DurationPolicy{},
 					},
 				}
 			})
@@ -54,11 +118,17 @@ var _ = Describe("StartupCPUBoost webhook", func() {
 		})
 		When("Startup CPU Boost has more than one duration policy", func() {
 			BeforeEach(func() {
-				boost = v1alpha1.StartupCPUBoost{
-					Spec: v1alpha1.StartupCPUBoostSpec{
-						DurationPolicy: v1alpha1.DurationPolicy{
-							Fixed:        &v1alpha1.FixedDurationPolicy{},
-							PodCondition: &v1alpha1.PodConditionDurationPolicy{},
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						DurationPolicy: v1beta1.DurationPolicy{
+							Fixed: &v1beta1.FixedDurationPolicy{
+								Unit:  v1beta1.FixedDurationPolicyUnitSec,
+								Value: 30,
+							},
+							PodCondition: &v1beta1.PodConditionDurationPolicy{
+								Type:   corev1.PodReady,
+								Status: corev1.ConditionTrue,
+							},
 						},
 					},
 				}
@@ -75,10 +145,13 @@ var _ = Describe("StartupCPUBoost webhook", func() {
 		})
 		When("Startup CPU Boost has one duration policy", func() {
 			BeforeEach(func() {
-				boost = v1alpha1.StartupCPUBoost{
-					Spec: v1alpha1.StartupCPUBoostSpec{
-						DurationPolicy: v1alpha1.DurationPolicy{
-							PodCondition: &v1alpha1.PodConditionDurationPolicy{},
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						DurationPolicy: v1beta1.DurationPolicy{
+							PodCondition: &v1beta1.PodConditionDurationPolicy{
+								Type:   corev1.PodReady,
+								Status: corev1.ConditionTrue,
+							},
 						},
 					},
 				}
@@ -93,22 +166,68 @@ var _ = Describe("StartupCPUBoost webhook", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+		When("Startup CPU Boost has no duration policy and references the profile", func() {
+			BeforeEach(func() {
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						ProfileRef: &v1beta1.ProfileReference{
+							Kind: v1beta1.ProfileKindCluster,
+							Name: "jvm",
+						},
+					},
+				}
+			})
+			It("does not error", func() {
+				By("validating create event")
+				_, err = w.ValidateCreate(context.TODO(), &boost)
+				Expect(err).NotTo(HaveOccurred())
+
+				By("validating update event")
+				_, err = w.ValidateUpdate(context.TODO(), nil, &boost)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+		When("Startup CPU Boost has invalid profile reference", func() {
+			BeforeEach(func() {
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						ProfileRef: &v1beta1.ProfileReference{
+							Kind: "Deployment",
+						},
+					},
+				}
+			})
+			It("errors", func() {
+				By("validating create event")
+				_, err = w.ValidateCreate(context.TODO(), &boost)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("spec.profileRef.kind"))
+				Expect(err.Error()).To(ContainSubstring("spec.profileRef.name"))
+
+				By("validating update event")
+				_, err = w.ValidateUpdate(context.TODO(), nil, &boost)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 		When("Startup CPU Boost has container without resource policies", func() {
 			BeforeEach(func() {
-				boost = v1alpha1.StartupCPUBoost{
-					Spec: v1alpha1.StartupCPUBoostSpec{
-						ResourcePolicy: v1alpha1.ResourcePolicy{
-							ContainerPolicies: []v1alpha1.ContainerPolicy{
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						ResourcePolicy: v1beta1.ResourcePolicy{
+							ContainerPolicies: []v1beta1.ContainerPolicy{
 								{
-									MatchContainers: &v1alpha1.MatchContainers{
-										Type:  v1alpha1.MatchContainersTypeExactName,
+									MatchContainers: &v1beta1.MatchContainers{
+										Type:  v1beta1.MatchContainersTypeExactName,
 										Value: "container-one",
 									},
 								},
 							},
 						},
-						DurationPolicy: v1alpha1.DurationPolicy{
-							PodCondition: &v1alpha1.PodConditionDurationPolicy{},
+						DurationPolicy: v1beta1.DurationPolicy{
+							PodCondition: &v1beta1.PodConditionDurationPolicy{
+								Type:   corev1.PodReady,
+								Status: corev1.ConditionTrue,
+							},
 						},
 					},
 				}
@@ -125,22 +244,25 @@ var _ = Describe("StartupCPUBoost webhook", func() {
 		})
 		When("Startup CPU Boost has container with two resource policies", func() {
 			BeforeEach(func() {
-				boost = v1alpha1.StartupCPUBoost{
-					Spec: v1alpha1.StartupCPUBoostSpec{
-						ResourcePolicy: v1alpha1.ResourcePolicy{
-							ContainerPolicies: []v1alpha1.ContainerPolicy{
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						ResourcePolicy: v1beta1.ResourcePolicy{
+							ContainerPolicies: []v1beta1.ContainerPolicy{
 								{
-									MatchContainers: &v1alpha1.MatchContainers{
-										Type:  v1alpha1.MatchContainersTypeExactName,
+									MatchContainers: &v1beta1.MatchContainers{
+										Type:  v1beta1.MatchContainersTypeExactName,
 										Value: "container-one",
 									},
-									FixedResources:     &v1alpha1.FixedResources{},
-									PercentageIncrease: &v1alpha1.PercentageIncrease{},
+									FixedResources:     &v1beta1.FixedResources{},
+									PercentageIncrease: &v1beta1.PercentageIncrease{},
 								},
 							},
 						},
-						DurationPolicy: v1alpha1.DurationPolicy{
-							PodCondition: &v1alpha1.PodConditionDurationPolicy{},
+						DurationPolicy: v1beta1.DurationPolicy{
+							PodCondition: &v1beta1.PodConditionDurationPolicy{
+								Type:   corev1.PodReady,
+								Status: corev1.ConditionTrue,
+							},
 						},
 					},
 				}
@@ -157,21 +279,26 @@ var _ = Describe("StartupCPUBoost webhook", func() {
 		})
 		When("Startup CPU Boost has container with one resource policies", func() {
 			BeforeEach(func() {
-				boost = v1alpha1.StartupCPUBoost{
-					Spec: v1alpha1.StartupCPUBoostSpec{
-						ResourcePolicy: v1alpha1.ResourcePolicy{
-							ContainerPolicies: []v1alpha1.ContainerPolicy{
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						ResourcePolicy: v1beta1.ResourcePolicy{
+							ContainerPolicies: []v1beta1.ContainerPolicy{
 								{
-									MatchContainers: &v1alpha1.MatchContainers{
-										Type:  v1alpha1.MatchContainersTypeExactName,
+									MatchContainers: &v1beta1.MatchContainers{
+										Type:  v1beta1.MatchContainersTypeExactName,
 										Value: "container-one",
 									},
-									FixedResources: &v1alpha1.FixedResources{},
+									FixedResources: &v1beta1.FixedResources{
+										Requests: apiResource.MustParse("1"),
+									},
 								},
 							},
 						},
-						DurationPolicy: v1alpha1.DurationPolicy{
-							PodCondition: &v1alpha1.PodConditionDurationPolicy{},
+						DurationPolicy: v1beta1.DurationPolicy{
+							PodCondition: &v1beta1.PodConditionDurationPolicy{
+								Type:   corev1.PodReady,
+								Status: corev1.ConditionTrue,
+							},
 						},
 					},
 				}
@@ -186,21 +313,24 @@ var _ = Describe("StartupCPUBoost webhook", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
-		When("Startup CPU Boost has container with container name and matcher", func() {
+		When("Startup CPU Boost has container without container matcher", func() {
 			BeforeEach(func() {
-				boost = v1alpha1.StartupCPUBoost{
-					Spec: v1alpha1.StartupCPUBoostSpec{
-						ResourcePolicy: v1alpha1.ResourcePolicy{
-							ContainerPolicies: []v1alpha1.ContainerPolicy{
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						ResourcePolicy: v1beta1.ResourcePolicy{
+							ContainerPolicies: []v1beta1.ContainerPolicy{
 								{
-									ContainerName:   "container-one",
-									MatchContainers: &v1alpha1.MatchContainers{},
-									FixedResources:  &v1alpha1.FixedResources{},
+									FixedResources: &v1beta1.FixedResources{
+										Requests: apiResource.MustParse("1"),
+									},
 								},
 							},
 						},
-						DurationPolicy: v1alpha1.DurationPolicy{
-							PodCondition: &v1alpha1.PodConditionDurationPolicy{},
+						DurationPolicy: v1beta1.DurationPolicy{
+							PodCondition: &v1beta1.PodConditionDurationPolicy{
+								Type:   corev1.PodReady,
+								Status: corev1.ConditionTrue,
+							},
 						},
 					},
 				}
@@ -217,21 +347,26 @@ var _ = Describe("StartupCPUBoost webhook", func() {
 		})
 		When("Startup CPU Boost has container matcher with invalid regex", func() {
 			BeforeEach(func() {
-				boost = v1alpha1.StartupCPUBoost{
-					Spec: v1alpha1.StartupCPUBoostSpec{
-						ResourcePolicy: v1alpha1.ResourcePolicy{
-							ContainerPolicies: []v1alpha1.ContainerPolicy{
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						ResourcePolicy: v1beta1.ResourcePolicy{
+							ContainerPolicies: []v1beta1.ContainerPolicy{
 								{
-									MatchContainers: &v1alpha1.MatchContainers{
-										Type:  v1alpha1.MatchContainersTypeRegexName,
+									MatchContainers: &v1beta1.MatchContainers{
+										Type:  v1beta1.MatchContainersTypeRegexName,
 										Value: "[invalid",
 									},
-									FixedResources: &v1alpha1.FixedResources{},
+									FixedResources: &v1beta1.FixedResources{
+										Requests: apiResource.MustParse("1"),
+									},
 								},
 							},
 						},
-						DurationPolicy: v1alpha1.DurationPolicy{
-							PodCondition: &v1alpha1.PodConditionDurationPolicy{},
+						DurationPolicy: v1beta1.DurationPolicy{
+							PodCondition: &v1beta1.PodConditionDurationPolicy{
+								Type:   corev1.PodReady,
+								Status: corev1.ConditionTrue,
+							},
 						},
 					},
 				}
@@ -246,34 +381,390 @@ var _ = Describe("StartupCPUBoost webhook", func() {
 				Expect(err).To(HaveOccurred())
 			})
 		})
-		When("Startup CPU Boost has container with deprecated containerName", func() {
+		When("Startup CPU Boost has semantic errors", func() {
 			BeforeEach(func() {
-				boost = v1alpha1.StartupCPUBoost{
-					Spec: v1alpha1.StartupCPUBoostSpec{
-						ResourcePolicy: v1alpha1.ResourcePolicy{
-							ContainerPolicies: []v1alpha1.ContainerPolicy{
+				boost = v1beta1.StartupCPUBoost{
+					Spec: v1beta1.StartupCPUBoostSpec{
+						Selector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "demo"},
+						},
+						ResourcePolicy: v1beta1.ResourcePolicy{
+							ContainerPolicies: []v1beta1.ContainerPolicy{
 								{
-									ContainerName:  "container-one",
-									FixedResources: &v1alpha1.FixedResources{},
+									MatchContainers: &v1beta1.MatchContainers{
+										Type:  v1beta1.MatchContainersTypeExactName,
+										Value: "container-one",
+									},
+									FixedResources: &v1beta1.FixedResources{
+										Requests: apiResource.MustParse("1"),
+										Limits:   apiResource.MustParse("2"),
+									},
+								},
+								{
+									MatchContainers: &v1beta1.MatchContainers{
+										Type:  v1beta1.MatchContainersTypeExactName,
+										Value: "container-two",
+									},
+									PercentageIncrease: &v1beta1.PercentageIncrease{Value: 50},
 								},
 							},
 						},
-						DurationPolicy: v1alpha1.DurationPolicy{
-							PodCondition: &v1alpha1.PodConditionDurationPolicy{},
+						DurationPolicy: v1beta1.DurationPolicy{
+							Fixed: &v1beta1.FixedDurationPolicy{
+								Unit:  v1beta1.FixedDurationPolicyUnitSec,
+								Value: 30,
+							},
+							PodCondition: &v1beta1.PodConditionDurationPolicy{
+								Type:   corev1.PodReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				}
+			})
+			It("does not error when valid", func() {
+				warnings, err := w.ValidateCreate(context.TODO(), &boost)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+			})
+			DescribeTable("errors",
+				func(mutate func(boost *v1beta1.StartupCPUBoost), fieldPath string) {
+					mutate(&boost)
+					_, err := w.ValidateCreate(context.TODO(), &boost)
+					Expect(err).To(MatchError(ContainSubstring(fieldPath)))
+				},
+				Entry("limits lower than requests", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].FixedResources.Limits = apiResource.MustParse("500m")
+				}, "spec.resourcePolicy.containerPolicies[0].fixedResources.limits"),
+				Entry("zero requests", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].FixedResources.Requests = apiResource.MustParse("0")
+				}, "spec.resourcePolicy.containerPolicies[0].fixedResources.requests"),
+				Entry("negative limits", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].FixedResources.Limits = apiResource.MustParse("-1")
+				}, "spec.resourcePolicy.containerPolicies[0].fixedResources.limits"),
+				Entry("zero percentage", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[1].PercentageIncrease.Value = 0
+				}, "spec.resourcePolicy.containerPolicies[1].percentageIncrease.value"),
+				Entry("negative fixed duration", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.DurationPolicy.Fixed.Value = -5
+				}, "spec.durationPolicy.fixedDuration.value"),
+				Entry("empty POD condition type", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.DurationPolicy.PodCondition.Type = ""
+				}, "spec.durationPolicy.podCondition.type"),
+				Entry("invalid POD condition status", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.DurationPolicy.PodCondition.Status = "Yes"
+				}, "spec.durationPolicy.podCondition.status"),
+				Entry("image tag with name container matcher", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers.Tag = "21-jre"
+				}, "spec.resourcePolicy.containerPolicies[0].matchContainers.tag"),
+				Entry("invalid image regular expression", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeImage,
+						Value: "^eclipse-temurin[$",
+					}
+				}, "spec.resourcePolicy.containerPolicies[0].matchContainers.value"),
+				Entry("invalid image digest", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type:   v1beta1.MatchContainersTypeImage,
+						Value:  "^eclipse-temurin$",
+						Digest: "abc123",
+					}
+				}, "spec.resourcePolicy.containerPolicies[0].matchContainers.digest"),
+				Entry("include rules with name container matcher", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers.Include = []v1beta1.MatchContainersRule{
+						{Type: v1beta1.MatchContainersTypeExactName, Value: "container-two"},
+					}
+				}, "spec.resourcePolicy.containerPolicies[0].matchContainers.include"),
+				Entry("composite container matcher without rules", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type: v1beta1.MatchContainersTypeComposite,
+					}
+				}, "spec.resourcePolicy.containerPolicies[0].matchContainers: Required value"),
+				Entry("nested composite container matcher", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type: v1beta1.MatchContainersTypeComposite,
+						Exclude: []v1beta1.MatchContainersRule{
+							{Type: v1beta1.MatchContainersTypeComposite},
+						},
+					}
+				}, "spec.resourcePolicy.containerPolicies[0].matchContainers.exclude[0].type"),
+				Entry("invalid regular expression in composite container matcher", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type: v1beta1.MatchContainersTypeComposite,
+						Include: []v1beta1.MatchContainersRule{
+							{Type: v1beta1.MatchContainersTypeRegexName, Value: "^app-[0-9+$"},
+						},
+					}
+				}, "spec.resourcePolicy.containerPolicies[0].matchContainers.include[0].value"),
+				Entry("invalid POD label selector", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchPod = &v1beta1.MatchPod{
+						Selector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "tier", Operator: metav1.LabelSelectorOpIn},
+							},
+						},
+					}
+				}, "spec.resourcePolicy.containerPolicies[0].matchPod.selector.matchExpressions[0].values"),
+				Entry("empty POD owner kind", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchPod = &v1beta1.MatchPod{
+						OwnerKinds: []string{"Job", ""},
+					}
+				}, "spec.resourcePolicy.containerPolicies[0].matchPod.ownerKinds[1]"),
+				Entry("invalid POD node selector key", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchPod = &v1beta1.MatchPod{
+						NodeSelectorKeys: []string{"cloud.google.com/gke-nodepool/"},
+					}
+				}, "spec.resourcePolicy.containerPolicies[0].matchPod.nodeSelectorKeys[0]"),
+				Entry("selector with target reference", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "apps/v1", Kind: "Deployment", Name: "demo",
+					}
+				}, "spec.selector"),
+				Entry("target reference without name", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.Selector = metav1.LabelSelector{}
+					boost.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "apps/v1", Kind: "Deployment",
+					}
+				}, "spec.targetRef.name"),
+				Entry("target reference with invalid API version", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.Selector = metav1.LabelSelector{}
+					boost.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "apps/v1/extra", Kind: "Deployment", Name: "demo",
+					}
+				}, "spec.targetRef.apiVersion"),
+				Entry("percentage override range with max lower than min", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.Overrides = &v1beta1.OverridePolicy{
+						Percentage: &v1beta1.PercentageOverrideRange{Min: 100, Max: 50},
+					}
+				}, "spec.overrides.percentage.max"),
+				Entry("duration override range with zero min", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.Overrides = &v1beta1.OverridePolicy{
+						Duration: &v1beta1.DurationOverrideRange{Min: 0, Max: 60},
+					}
+				}, "spec.overrides.duration.min"),
+			)
+			DescribeTable("warns",
+				func(mutate func(boost *v1beta1.StartupCPUBoost), warning string) {
+					mutate(&boost)
+					warnings, err := w.ValidateCreate(context.TODO(), &boost)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf(warning))
+				},
+				Entry("empty selector", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.Selector = metav1.LabelSelector{}
+				}, "spec.selector is empty and matches all PODs in the namespace"),
+				Entry("unknown POD condition type", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.DurationPolicy.PodCondition.Type = "example.com/Warmed"
+				}, "spec.durationPolicy.podCondition.type \"example.com/Warmed\" is not a known POD condition type; "+
+					"the resources are reverted only if the condition is set on the POD, i.e. by a readiness gate"),
+				Entry("invalid exact container name", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[1].MatchContainers.Value = "Container_Two"
+				}, "spec.resourcePolicy.containerPolicies[1].matchContainers value \"Container_Two\" "+
+					"is not a valid container name and matches no container"),
+				Entry("regular expression matching no container name", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[1].MatchContainers = &v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeRegexName,
+						Value: "^app_(one|two)$",
+					}
+				}, "spec.resourcePolicy.containerPolicies[1].matchContainers regular expression \"^app_(one|two)$\" "+
+					"matches no valid container name"),
+				Entry("duplicated container matcher", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[1].MatchContainers.Value = "container-one"
+				}, "spec.resourcePolicy.containerPolicies[1].matchContainers is shadowed by "+
+					"spec.resourcePolicy.containerPolicies[0]; the policy is never used as the first matching policy applies"),
+				Entry("container matcher shadowed by regular expression", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeRegexName,
+						Value: "^container-",
+					}
+				}, "spec.resourcePolicy.containerPolicies[1].matchContainers is shadowed by "+
+					"spec.resourcePolicy.containerPolicies[0]; the policy is never used as the first matching policy applies"),
+				Entry("container matcher shadowed by catch-all regular expression", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeRegexName,
+						Value: ".*",
+					}
+					boost.Spec.ResourcePolicy.ContainerPolicies[1].MatchContainers = &v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeRegexName,
+						Value: "^sidecar-[a-z]+$",
+					}
+				}, "spec.resourcePolicy.containerPolicies[1].matchContainers is shadowed by "+
+					"spec.resourcePolicy.containerPolicies[0]; the policy is never used as the first matching policy applies"),
+				Entry("container matcher shadowed by anchored catch-all regular expression", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeRegexName,
+						Value: "^.*$",
+					}
+				}, "spec.resourcePolicy.containerPolicies[1].matchContainers is shadowed by "+
+					"spec.resourcePolicy.containerPolicies[0]; the policy is never used as the first matching policy applies"),
+				Entry("container matcher shadowed by composite catch-all matcher", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type: v1beta1.MatchContainersTypeComposite,
+						Include: []v1beta1.MatchContainersRule{{
+							Type:  v1beta1.MatchContainersTypeRegexName,
+							Value: "^.+$",
+						}},
+					}
+				}, "spec.resourcePolicy.containerPolicies[1].matchContainers is shadowed by "+
+					"spec.resourcePolicy.containerPolicies[0]; the policy is never used as the first matching policy applies"),
+				Entry("duration override without fixed duration policy", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.DurationPolicy.Fixed = nil
+					boost.Spec.Overrides = &v1beta1.OverridePolicy{
+						Duration: &v1beta1.DurationOverrideRange{Min: 10, Max: 60},
+					}
+				}, "spec.overrides.duration has no effect without the fixed duration policy"),
+				Entry("percentage override without percentage increase policy", func(boost *v1beta1.StartupCPUBoost) {
+					boost.Spec.ResourcePolicy.ContainerPolicies = boost.Spec.ResourcePolicy.ContainerPolicies[:1]
+					boost.Spec.Overrides = &v1beta1.OverridePolicy{
+						Percentage: &v1beta1.PercentageOverrideRange{Min: 20, Max: 200},
+					}
+				}, "spec.overrides.percentage has no effect without the percentage increase resource policy"),
+			)
+			When("composite container matcher excludes containers", func() {
+				BeforeEach(func() {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type: v1beta1.MatchContainersTypeComposite,
+						Exclude: []v1beta1.MatchContainersRule{
+							{Type: v1beta1.MatchContainersTypeExactName, Value: "istio-proxy"},
+							{Type: v1beta1.MatchContainersTypeExactName, Value: "Fluent_Bit"},
 						},
+					}
+				})
+				It("warns about the rule matching no container", func() {
+					warnings, err := w.ValidateCreate(context.TODO(), &boost)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf(
+						"spec.resourcePolicy.containerPolicies[0].matchContainers.exclude[1] value \"Fluent_Bit\" " +
+							"is not a valid container name and matches no container"))
+				})
+			})
+			When("target reference is set without selector", func() {
+				BeforeEach(func() {
+					boost.Spec.Selector = metav1.LabelSelector{}
+					boost.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
+						APIVersion: "apps/v1", Kind: "Deployment", Name: "demo",
+					}
+				})
+				It("does not error nor warn", func() {
+					warnings, err := w.ValidateCreate(context.TODO(), &boost)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(BeEmpty())
+				})
+			})
+			When("former container policy has POD matching rules", func() {
+				BeforeEach(func() {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchPod = &v1beta1.MatchPod{
+						PriorityClassNames: []string{"high-priority"},
+					}
+					boost.Spec.ResourcePolicy.ContainerPolicies[1].MatchContainers.Value = "container-one"
+				})
+				It("does not warn about the shadowed policy", func() {
+					warnings, err := w.ValidateCreate(context.TODO(), &boost)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(BeEmpty())
+				})
+			})
+			When("image container matchers differ by tag", func() {
+				BeforeEach(func() {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type:   v1beta1.MatchContainersTypeImage,
+						Value:  "^eclipse-temurin$",
+						Tag:    "21-jre",
+						Digest: "sha256:abc123",
+					}
+					boost.Spec.ResourcePolicy.ContainerPolicies[1].MatchContainers = &v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeImage,
+						Value: "^eclipse-temurin$",
+						Tag:   "17-jre",
+					}
+				})
+				It("does not error nor warn", func() {
+					warnings, err := w.ValidateCreate(context.TODO(), &boost)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(BeEmpty())
+				})
+			})
+			When("regular expression matches container names", func() {
+				BeforeEach(func() {
+					boost.Spec.ResourcePolicy.ContainerPolicies[0].MatchContainers = &v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeRegexName,
+						Value: "^(?i)APP-[0-9]+$",
+					}
+					boost.Spec.ResourcePolicy.ContainerPolicies[1].MatchContainers = &v1beta1.MatchContainers{
+						Type:  v1beta1.MatchContainersTypeRegexName,
+						Value: "^container-.*$",
+					}
+				})
+				It("does not warn", func() {
+					warnings, err := w.ValidateCreate(context.TODO(), &boost)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(warnings).To(BeEmpty())
+				})
+			})
+		})
+		When("Startup CPU Boost selector overlaps with existing boosts", func() {
+			var existing []v1beta1.StartupCPUBoost
+			BeforeEach(func() {
+				mockCtrl := gomock.NewController(GinkgoT())
+				mockClient := mock.NewMockClient(mockCtrl)
+				w = webhook.StartupCPUBoostWebhook{Client: mockClient}
+				boost = v1beta1.StartupCPUBoost{
+					ObjectMeta: metav1.ObjectMeta{Name: "boost-002", Namespace: "demo"},
+					Spec: v1beta1.StartupCPUBoostSpec{
+						Selector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "demo"},
+						},
+						DurationPolicy: v1beta1.DurationPolicy{
+							PodCondition: &v1beta1.PodConditionDurationPolicy{
+								Type:   corev1.PodReady,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				}
+				existing = []v1beta1.StartupCPUBoost{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "boost-001", Namespace: "demo"},
+						Spec: v1beta1.StartupCPUBoostSpec{
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "demo", "tier": "web"},
+							},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "boost-003", Namespace: "demo"},
+						Spec: v1beta1.StartupCPUBoostSpec{
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "other"},
+							},
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "boost-004", Namespace: "demo"},
+						Spec:       v1beta1.StartupCPUBoostSpec{Priority: -1},
 					},
+					*boost.DeepCopy(),
 				}
+				mockClient.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Eq(client.InNamespace("demo"))).
+					AnyTimes().DoAndReturn(func(c context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					list.(*v1beta1.StartupCPUBoostList).Items = existing
+					return nil
+				})
 			})
-			It("returns deprecation warning", func() {
+			It("returns overlap warnings", func() {
+				expected := []string{
+					"selector overlaps with StartupCPUBoost boost-001 (priority 0); boost-001 is applied to PODs matched by both",
+					"selector overlaps with StartupCPUBoost boost-004 (priority -1); boost-002 is applied to PODs matched by both",
+				}
 				By("validating create event")
 				warnings, err := w.ValidateCreate(context.TODO(), &boost)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(warnings).To(ConsistOf("spec.resourcePolicy.containerPolicies[0].containerName is deprecated; use matchContainers with type=ExactName instead"))
+				Expect(warnings).To(ConsistOf(expected))
 
 				By("validating update event")
 				warnings, err = w.ValidateUpdate(context.TODO(), nil, &boost)
 				Expect(err).NotTo(HaveOccurred())
-				Expect(warnings).To(ConsistOf("spec.resourcePolicy.containerPolicies[0].containerName is deprecated; use matchContainers with type=ExactName instead"))
+				Expect(warnings).To(ConsistOf(expected))
 			})
 		})
 	})